@@ -0,0 +1,87 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"main.go/i18n"
+	"main.go/lemin"
+	"main.go/parser"
+)
+
+// runBench, "bench klasör" alt komutunu çalıştırır: klasördeki her haritayı kayıtlı her çözücüyle
+// çözer ve tur sayısı, alt sınır, süre, bellek ayırma ve incelenen yol sayısını tablo olarak yazdırır.
+// Süre ve bellek ölçümü yalnızca Solve çağrısını kapsar; okuma ve yazdırma dahil değildir.
+func runBench(args []string) {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	solverList := flags.String("solvers", strings.Join(benchSolvers(), ","), i18n.T("solvers to compare (comma separated)"))
+	timeout := flags.Duration("timeout", 10*time.Second, i18n.T("time limit for each solver"))
+	langFlag(flags)
+	parseFlags(flags, args)
+
+	if flags.NArg() != 1 {
+		failUsage("usage: lem-in bench [-solvers=a,b] [-timeout=10s] directory")
+	}
+	entries, err := os.ReadDir(flags.Arg(0))
+	if err != nil {
+		fail(err)
+	}
+
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, i18n.T("MAP\tSOLVER\tTURNS\tLOWER BOUND\tTIME\tALLOCS\tBYTES\tPATHS\t"))
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		input, err := os.ReadFile(filepath.Join(flags.Arg(0), name))
+		if err != nil {
+			fmt.Fprintf(table, "%s\t-\t%s\t\t\t\t\t\t\n", name, i18n.T("read error"))
+			continue
+		}
+		graph, antCount, err := parser.ParseAny(input)
+		if err != nil {
+			fmt.Fprintf(table, "%s\t-\t%s\t\t\t\t\t\t\n", name, i18n.T("invalid map"))
+			continue
+		}
+		lowerBound := graph.LowerBound(antCount, lemin.Rules{})
+
+		for _, solverName := range strings.Split(*solverList, ",") {
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			start := time.Now()
+			solution, err := lemin.Solve(graph, antCount, lemin.Options{Solver: solverName, Timeout: *timeout})
+			elapsed := time.Since(start)
+			runtime.ReadMemStats(&after)
+
+			if err != nil {
+				fmt.Fprintf(table, "%s\t%s\t%s\t%d\t%s\t\t\t\t\n", name, solverName, describe(err), lowerBound, elapsed.Round(time.Microsecond))
+				continue
+			}
+			fmt.Fprintf(table, "%s\t%s\t%d\t%d\t%s\t%d\t%d\t%d\t\n", name, solverName, len(solution.Turns), lowerBound,
+				elapsed.Round(time.Microsecond), after.Mallocs-before.Mallocs, after.TotalAlloc-before.TotalAlloc, solution.Explored)
+		}
+	}
+	table.Flush()
+}
+
+// benchSolvers, varsayılan olarak karşılaştırılan çözücülerdir. Üstel süreli ve bellekli "bfs"
+// çözücüsü büyük haritalarda her seferinde zaman aşımına kadar çalışacağı için yalnızca -solvers
+// ile istendiğinde kullanılır.
+func benchSolvers() []string {
+	names := []string{}
+	for _, name := range lemin.SolverNames() {
+		if name != "bfs" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
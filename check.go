@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"main.go/i18n"
+	"main.go/lemin"
+	"main.go/parser"
+)
+
+// runCheck, "check harita.txt cozum.txt" alt komutunu çalıştırır: çözümdeki hamleleri haritaya
+// göre baştan oynatır ve ilk kural dışı hamleyi raporlar. Çözüm dosyası "-" ise standart girişten okunur.
+func runCheck(args []string) {
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+	oneAntPerTunnel := flags.Bool("one-ant-per-tunnel", false, i18n.T("at most one ant may use each link per turn"))
+	langFlag(flags)
+	parseFlags(flags, args)
+	args = flags.Args()
+
+	if len(args) != 2 {
+		failUsage("usage: lem-in check [-one-ant-per-tunnel] map.txt solution.txt")
+	}
+
+	mapInput, err := os.ReadFile(args[0])
+	if err != nil {
+		fail(err)
+	}
+	graph, antCount, err := parser.ParseAny(mapInput)
+	if err != nil {
+		fail(err)
+	}
+
+	solution, err := readInput(args[1])
+	if err != nil {
+		fail(err)
+	}
+	turns, err := parser.ParseMoves(bytes.NewReader(solution), graph)
+	if err != nil {
+		fail(err)
+	}
+
+	rules := lemin.Rules{OneAntPerTunnel: *oneAntPerTunnel}
+	if err := graph.CheckMovesWithRules(antCount, turns, rules); err != nil {
+		fail(err)
+	}
+	lowerBound := graph.LowerBound(antCount, rules)
+	fmt.Println(i18n.T("Solution is valid: %d ants, %d turns (lower bound: %d, gap: %d)", antCount, len(turns), lowerBound, len(turns)-lowerBound))
+}
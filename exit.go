@@ -0,0 +1,144 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"main.go/generator"
+	"main.go/i18n"
+	"main.go/lemin"
+	"main.go/parser"
+)
+
+// Çıkış kodları; betikler hatanın türünü bunlarla ayırt edebilir.
+const (
+	exitFailure    = 1 // Diğer hatalar (ör. çıktı yazılamadı)
+	exitUsage      = 2 // Hatalı komut satırı: bilinmeyen bayrak, çözücü, harita türü, fazla argüman
+	exitIO         = 3 // Dosya veya klasör okunamadı ya da yazılamadı
+	exitParse      = 4 // Harita veya çözüm dosyasında sözdizimi hatası
+	exitValidation = 5 // Harita kuralları veya çözümdeki hamleler geçersiz
+	exitNoPath     = 6 // Başlangıçtan bitişe yol yok
+	exitTimeout    = 7 // Çözücü süre sınırını aştı
+)
+
+// errorMessages, kütüphanelerin hata değerlerinin İngilizce açıklamalarıdır; i18n.T ile seçilen dile çevrilir.
+var errorMessages = map[error]string{
+	lemin.ErrInvalidAntCount:      "invalid number of ants",
+	lemin.ErrNoPath:               "no path from start to end",
+	lemin.ErrStalled:              "the simulation stalled before every ant reached the end",
+	lemin.ErrTimeout:              "the solver timed out",
+	lemin.ErrUnknownSolver:        "unknown solver",
+	lemin.ErrDuplicateRoom:        "a room with the same name is already defined",
+	lemin.ErrDuplicateCoordinates: "a room with the same coordinates is already defined",
+	lemin.ErrInvalidRoomName:      "room names cannot start with 'L' or '#'",
+	lemin.ErrSelfLink:             "a room cannot be linked to itself",
+	lemin.ErrDuplicateLink:        "link is already defined",
+	lemin.ErrMultipleStart:        "more than one ##start command",
+	lemin.ErrMultipleEnd:          "more than one ##end command",
+	lemin.ErrRoomAfterLink:        "room defined after the links",
+	lemin.ErrUnparsedLine:         "line is neither a room nor a link",
+	lemin.ErrUnknownAnt:           "no such ant",
+	lemin.ErrAntMovedTwice:        "ant moved twice in the same turn",
+	lemin.ErrAntAlreadyAtEnd:      "ant moved after reaching the end",
+	lemin.ErrNoLink:               "the rooms are not linked",
+	lemin.ErrRoomOccupied:         "more ants in the room than it can hold",
+	lemin.ErrAntsNotAtEnd:         "not all ants reached the end",
+	lemin.ErrTunnelBusy:           "more ants entered the link in the same turn than it can hold",
+	lemin.ErrAntInTransit:         "the ant arrived before it could cross the link",
+	parser.ErrInvalidRoom:         "invalid room definition",
+	parser.ErrInvalidCapacity:     "room capacity must be a positive integer",
+	parser.ErrInvalidLink:         "invalid link definition",
+	parser.ErrInvalidLinkProperty: "link capacity and length must be positive integers",
+	parser.ErrUnknownRoomInLink:   "link refers to an unknown room",
+	parser.ErrNoStart:             "no start room given",
+	parser.ErrNoEnd:               "no end room given",
+	parser.ErrNoRoomAfterCommand:  "no room definition after the command",
+	parser.ErrInvalidDOT:          "invalid or unsupported DOT syntax",
+	parser.ErrInvalidMove:         "invalid move, expected \"Lx-room\"",
+	parser.ErrUnknownRoomInMove:   "move refers to an unknown room",
+	generator.ErrUnknownKind:      "unknown map type",
+	generator.ErrInvalidSize:      "invalid size",
+}
+
+// fail, hatayı standart hataya "ERROR: <neden>" olarak yazar ve hatanın türüne uygun kodla çıkar.
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, i18n.T("ERROR:"), describe(err))
+	os.Exit(exitCode(err))
+}
+
+// failUsage, bir kullanım hatasını standart hataya yazar ve exitUsage koduyla çıkar.
+// format, i18n kataloğundaki İngilizce mesajdır.
+func failUsage(format string, args ...interface{}) {
+	fmt.Fprintln(os.Stderr, i18n.T("ERROR:"), i18n.T(format, args...))
+	os.Exit(exitUsage)
+}
+
+// describe, hatayı seçilen dilde açıklar. Satır ve tur bilgisi taşıyan hatalar parçalarına ayrılarak,
+// kütüphanelerin hata değerleri errorMessages üzerinden çevrilir; tanınmayan hatalar olduğu gibi kalır.
+func describe(err error) string {
+	var validation lemin.ValidationErrors
+	var validationErr *lemin.ValidationError
+	var moveErr *lemin.MoveError
+	var parseErr *parser.ParseError
+	var pathErr *fs.PathError
+	switch {
+	case errors.As(err, &validation):
+		lines := make([]string, len(validation))
+		for i, violation := range validation {
+			lines[i] = describe(violation)
+		}
+		return strings.Join(lines, "\n")
+	case errors.As(err, &validationErr):
+		if validationErr.Line == 0 {
+			return fmt.Sprintf("%q: %s", validationErr.Text, describe(validationErr.Err))
+		}
+		return i18n.T("line %d %q: %s", validationErr.Line, validationErr.Text, describe(validationErr.Err))
+	case errors.As(err, &parseErr):
+		if parseErr.Line == 0 {
+			return describe(parseErr.Err)
+		}
+		return i18n.T("line %d %q: %s", parseErr.Line, parseErr.Text, describe(parseErr.Err))
+	case errors.As(err, &moveErr):
+		if moveErr.Turn == 0 {
+			return describe(moveErr.Err)
+		}
+		return i18n.T("turn %d %q: %s", moveErr.Turn, moveErr.Move, describe(moveErr.Err))
+	case errors.As(err, &pathErr):
+		return i18n.T("cannot open %s: %v", pathErr.Path, pathErr.Err)
+	}
+
+	// "bilinmeyen çözücü: \"x\"" gibi sarmalanmış hatalarda yalnızca hata değerinin metni çevrilir.
+	message := err.Error()
+	for target, english := range errorMessages {
+		if errors.Is(err, target) && strings.HasPrefix(message, target.Error()) {
+			return i18n.T(english) + message[len(target.Error()):]
+		}
+	}
+	return message
+}
+
+// exitCode, hatanın türüne karşılık gelen çıkış kodunu döndürür.
+func exitCode(err error) int {
+	var validation lemin.ValidationErrors
+	var moveErr *lemin.MoveError
+	var parseErr *parser.ParseError
+	var pathErr *fs.PathError
+	switch {
+	case errors.Is(err, lemin.ErrTimeout):
+		return exitTimeout
+	case errors.Is(err, lemin.ErrNoPath):
+		return exitNoPath
+	case errors.Is(err, lemin.ErrUnknownSolver), errors.Is(err, generator.ErrUnknownKind), errors.Is(err, generator.ErrInvalidSize):
+		return exitUsage
+	case errors.As(err, &validation), errors.As(err, &moveErr):
+		return exitValidation
+	case errors.As(err, &parseErr):
+		return exitParse
+	case errors.As(err, &pathErr):
+		return exitIO
+	}
+	return exitFailure
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"main.go/generator"
+	"main.go/i18n"
+	"main.go/lemin"
+)
+
+// runGenerate, "generate" alt komutunu çalıştırır: istenen türde bir harita üretip standart
+// çıktıya yazar. Beklenen alt sınır tur sayısı haritaya yorum olarak eklenir.
+func runGenerate(args []string) {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	kind := flags.String("type", "random", i18n.T("map type: ")+strings.Join(generator.Kinds, ", "))
+	size := flags.Int("size", 10, i18n.T("map size (number of rooms, side length or number of paths, depending on the type)"))
+	ants := flags.Int("ants", 10, i18n.T("number of ants"))
+	seed := flags.Int64("seed", 1, i18n.T("random number generator seed"))
+	langFlag(flags)
+	parseFlags(flags, args)
+
+	if *ants <= 0 {
+		failUsage("the number of ants must be positive")
+	}
+
+	graph, err := generator.Generate(generator.Options{Kind: *kind, Size: *size, Seed: *seed})
+	if err != nil {
+		fail(err)
+	}
+
+	comments := []string{
+		fmt.Sprintf("generate -type=%s -size=%d -ants=%d -seed=%d", *kind, *size, *ants, *seed),
+		i18n.T("expected lower bound: %d turns", graph.LowerBound(*ants, lemin.Rules{})),
+	}
+	if err := generator.Write(os.Stdout, graph, *ants, comments...); err != nil {
+		fail(err)
+	}
+}
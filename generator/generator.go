@@ -0,0 +1,189 @@
+// Package generator, test için farklı yapılarda geçerli lem-in haritaları üretir.
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"main.go/lemin"
+)
+
+// Kinds, üretilebilen harita türleridir.
+var Kinds = []string{"random", "grid", "corridor", "parallel", "trap"}
+
+// Generate'in döndürdüğü hatalar
+var (
+	ErrUnknownKind = errors.New("bilinmeyen harita türü") // Options.Kind desteklenmeyen bir tür
+	ErrInvalidSize = errors.New("geçersiz boyut")         // Options.Size 1'den küçük
+)
+
+// Options, üretilecek haritanın türünü ve boyutunu belirler.
+type Options struct {
+	Kind string // Harita türü (Kinds içinden biri)
+	Size int    // Haritanın boyutu; türüne göre oda sayısı, kenar uzunluğu veya yol sayısı
+	Seed int64  // Rastgele sayı üreteci tohumu; aynı tohum aynı haritayı üretir
+}
+
+// Generate, verilen seçeneklere göre başlangıçtan bitişe en az bir yolu olan bir harita üretir.
+func Generate(opts Options) (*lemin.Graph, error) {
+	if opts.Size < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSize, opts.Size)
+	}
+	random := rand.New(rand.NewSource(opts.Seed))
+
+	switch opts.Kind {
+	case "random":
+		return randomMap(opts.Size, random), nil
+	case "grid":
+		return gridMap(opts.Size), nil
+	case "corridor":
+		return corridorMap(opts.Size), nil
+	case "parallel":
+		return parallelMap(opts.Size, random), nil
+	case "trap":
+		return trapMap(opts.Size), nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownKind, opts.Kind)
+}
+
+// randomMap, size odalı, rastgele koordinatlı ve seyrek bağlantılı bir harita üretir.
+// Odalar önce rastgele bir ağaçla birbirine bağlanır, sonra yaklaşık size/2 ek bağlantı eklenir.
+func randomMap(size int, random *rand.Rand) *lemin.Graph {
+	g := lemin.NewGraph()
+	if size < 2 {
+		size = 2
+	}
+
+	// Her odaya farklı bir koordinat ver.
+	used := make(map[[2]int]bool)
+	for i := 0; i < size; i++ {
+		x, y := random.Intn(size*4), random.Intn(size*4)
+		for used[[2]int{x, y}] {
+			x, y = random.Intn(size*4), random.Intn(size*4)
+		}
+		used[[2]int{x, y}] = true
+		g.AddNode(roomName(i, size), x, y)
+	}
+	g.StartNodeID = 0
+	g.EndNodeID = size - 1
+
+	// Ağaç bağlantıları haritanın bağlı olmasını sağlar.
+	links := make(map[[2]int]bool)
+	addLink := func(a, b int) {
+		if a == b || links[[2]int{a, b}] || links[[2]int{b, a}] {
+			return
+		}
+		links[[2]int{a, b}] = true
+		g.AddEdge(a, b)
+	}
+	for i := 1; i < size; i++ {
+		addLink(i, random.Intn(i))
+	}
+	for i := 0; i < size/2; i++ {
+		addLink(random.Intn(size), random.Intn(size))
+	}
+	return g
+}
+
+// gridMap, size x size boyutunda bir ızgara üretir; başlangıç sol üst, bitiş sağ alt köşededir.
+func gridMap(size int) *lemin.Graph {
+	g := lemin.NewGraph()
+	id := func(row, col int) int { return row*size + col }
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			g.AddNode(fmt.Sprintf("g%d_%d", row, col), col, row)
+		}
+	}
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			if col+1 < size {
+				g.AddEdge(id(row, col), id(row, col+1))
+			}
+			if row+1 < size {
+				g.AddEdge(id(row, col), id(row+1, col))
+			}
+		}
+	}
+	g.StartNodeID = id(0, 0)
+	g.EndNodeID = id(size-1, size-1)
+	if size == 1 {
+		// Tek odalı ızgarada başlangıç ve bitiş aynı olamaz; yanına bir bitiş odası eklenir.
+		g.EndNodeID = g.AddNode("g0_1", 1, 0)
+		g.AddEdge(g.StartNodeID, g.EndNodeID)
+	}
+	return g
+}
+
+// corridorMap, başlangıçtan bitişe size ara odalı tek bir koridor üretir.
+func corridorMap(size int) *lemin.Graph {
+	g := lemin.NewGraph()
+	g.StartNodeID = g.AddNode("start", 0, 0)
+	previous := g.StartNodeID
+	for i := 1; i <= size; i++ {
+		room := g.AddNode(fmt.Sprintf("c%d", i), i, 0)
+		g.AddEdge(previous, room)
+		previous = room
+	}
+	g.EndNodeID = g.AddNode("end", size+1, 0)
+	g.AddEdge(previous, g.EndNodeID)
+	return g
+}
+
+// parallelMap, başlangıçtan bitişe size adet birbirinden bağımsız ve uzunlukları
+// 1 ile size arasında rastgele olan yol üretir.
+func parallelMap(size int, random *rand.Rand) *lemin.Graph {
+	g := lemin.NewGraph()
+	g.StartNodeID = g.AddNode("start", 0, size)
+	g.EndNodeID = g.AddNode("end", size+1, size)
+	for p := 0; p < size; p++ {
+		length := 1 + random.Intn(size)
+		previous := g.StartNodeID
+		for i := 1; i <= length; i++ {
+			room := g.AddNode(fmt.Sprintf("p%d_%d", p, i), i, 2*p)
+			g.AddEdge(previous, room)
+			previous = room
+		}
+		g.AddEdge(previous, g.EndNodeID)
+	}
+	return g
+}
+
+// trapMap, size çift uzun ve ayrık yol üretir; her çiftin ilk yolunun ilk odası ile ikinci yolunun
+// son odası arasına bir kısayol eklenir. En kısa yol kısayolu kullanır ve iki uzun yolu birden
+// tıkar; açgözlü bir çözücü bu yüzden daha az yol bulur.
+func trapMap(size int) *lemin.Graph {
+	g := lemin.NewGraph()
+	length := size + 2 // Her uzun yoldaki ara oda sayısı
+	g.StartNodeID = g.AddNode("start", 0, 2*size)
+	g.EndNodeID = g.AddNode("end", length+1, 2*size)
+	for pair := 0; pair < size; pair++ {
+		var first, last [2]int // Çiftteki iki yolun ilk ve son odaları
+		for side := 0; side < 2; side++ {
+			previous := g.StartNodeID
+			for i := 1; i <= length; i++ {
+				room := g.AddNode(fmt.Sprintf("t%d_%d_%d", pair, side, i), i, 4*pair+2*side)
+				g.AddEdge(previous, room)
+				if i == 1 {
+					first[side] = room
+				}
+				previous = room
+			}
+			g.AddEdge(previous, g.EndNodeID)
+			last[side] = previous
+		}
+		g.AddEdge(first[0], last[1]) // Kısayol
+	}
+	return g
+}
+
+// roomName, rastgele haritadaki i. odanın ismini verir; ilk oda başlangıç, son oda bitiştir.
+func roomName(i int, size int) string {
+	switch i {
+	case 0:
+		return "start"
+	case size - 1:
+		return "end"
+	}
+	return fmt.Sprintf("r%d", i)
+}
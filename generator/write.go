@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	"main.go/lemin"
+)
+
+// Write, grafı lem-in harita biçiminde w'ye yazar: karınca sayısı, verilen yorum satırları,
+// odalar (başlangıç ve bitiş odalarından önce ##start/##end komutlarıyla, kapasitesi birden büyük
+// odalar için dördüncü alanda kapasiteyle) ve bağlantılar (kapasitesi veya süresi belirtilmişse
+// "a-b:kapasite:süre" biçiminde).
+func Write(w io.Writer, g *lemin.Graph, antCount int, comments ...string) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, antCount)
+	for _, comment := range comments {
+		fmt.Fprintf(out, "# %s\n", comment)
+	}
+	for _, node := range g.Nodes {
+		switch node.ID {
+		case g.StartNodeID:
+			fmt.Fprintln(out, "##start")
+		case g.EndNodeID:
+			fmt.Fprintln(out, "##end")
+		}
+		if node.Capacity > 1 {
+			fmt.Fprintf(out, "%s %d %d %d\n", node.Name, node.X, node.Y, node.Capacity)
+		} else {
+			fmt.Fprintf(out, "%s %d %d\n", node.Name, node.X, node.Y)
+		}
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(out, "%s-%s%s\n", g.Nodes[edge.Start].Name, g.Nodes[edge.End].Name, linkProperties(edge))
+	}
+	return out.Flush()
+}
+
+// linkProperties, bağlantının belirtilmiş kapasitesini ve süresini ":kapasite:süre" biçiminde
+// döndürür; ikisi de belirtilmemişse boş döner.
+func linkProperties(edge lemin.Edge) string {
+	capacity := ""
+	if edge.Capacity > 0 {
+		capacity = strconv.Itoa(edge.Capacity)
+	}
+	switch {
+	case edge.Length > 1:
+		return fmt.Sprintf(":%s:%d", capacity, edge.Length)
+	case capacity != "":
+		return ":" + capacity
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+// Package i18n, komut satırı mesajlarını seçilen dilde döndürür. Mesajlar İngilizce metinleriyle
+// anahtarlanır; İngilizce varsayılan dildir, Türkçe karşılıklar tr.go'daki katalogdadır.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Desteklenen diller
+const (
+	English = "en"
+	Turkish = "tr"
+)
+
+// catalogs, dillere göre İngilizce mesajların karşılıklarıdır.
+var catalogs = map[string]map[string]string{
+	Turkish: turkish,
+}
+
+// language, T'nin kullandığı dildir.
+var language = English
+
+// SetLanguage, mesajların dilini ayarlar; desteklenmeyen diller için İngilizce kullanılır.
+func SetLanguage(lang string) {
+	language = normalize(lang)
+}
+
+// Language, geçerli dili döndürür.
+func Language() string {
+	return language
+}
+
+// T, İngilizce mesajın geçerli dildeki karşılığını döndürür; args verilirse mesaj fmt.Sprintf
+// biçimi olarak kullanılır. Katalogda karşılığı olmayan mesajlar İngilizce kalır.
+func T(message string, args ...interface{}) string {
+	if translated, ok := catalogs[language][message]; ok {
+		message = translated
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// Detect, komut satırındaki "-lang"/"--lang" bayrağına, yoksa LC_ALL, LC_MESSAGES ve LANG ortam
+// değişkenlerine bakarak kullanılacak dili belirler. Bayraklar henüz tanımlanmadan, yardım
+// metinleri de doğru dilde yazılsın diye çağrılır.
+func Detect(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == arg {
+			continue
+		}
+		if value := strings.TrimPrefix(name, "lang="); value != name {
+			return normalize(value)
+		}
+		if name == "lang" && i+1 < len(args) {
+			return normalize(args[i+1])
+		}
+	}
+	for _, key := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(key); value != "" {
+			return normalize(value)
+		}
+	}
+	return English
+}
+
+// normalize, "tr_TR.UTF-8" gibi yerel ayar adlarını desteklenen bir dil koduna çevirir.
+func normalize(lang string) string {
+	if strings.HasPrefix(strings.ToLower(lang), Turkish) {
+		return Turkish
+	}
+	return English
+}
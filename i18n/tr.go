@@ -0,0 +1,101 @@
+package i18n
+
+// turkish, İngilizce mesajların Türkçe karşılıklarıdır.
+var turkish = map[string]string{
+	// Rapor ve çıktı
+	"Rooms:":                              "Odalar:",
+	"Links:":                              "Bağlantılar:",
+	"Number of ants: %d":                  "Karınca sayısı: %d",
+	"Start room: %d":                      "Başlangıç odası: %d",
+	"End room: %d":                        "Bitiş odası: %d",
+	"Step %d: %s":                         "Adım %d: %s",
+	"Total time: %.9f seconds":            "Toplam süre: %.9f saniye",
+	"Turns: %d, lower bound: %d, gap: %d": "Tur sayısı: %d, alt sınır: %d, fark: %d",
+	"expected lower bound: %d turns":      "beklenen alt sınır: %d tur",
+	"read error":                          "okuma hatası",
+	"invalid map":                         "geçersiz harita",
+	"Turn %d/%d":                          "Tur %d/%d",
+	"Press Enter to continue...":          "Devam etmek için Enter'a basın...",
+	"Path %d: %d ants, %d links":          "Yol %d: %d karınca, %d bağlantı",
+	"Play":                                "Oynat",
+	"Pause":                               "Duraklat",
+	"Speed":                               "Hız",
+	"Turn":                                "Tur",
+	"MAP\tSOLVER\tTURNS\tLOWER BOUND\tTIME\tALLOCS\tBYTES\tPATHS\t":   "HARİTA\tÇÖZÜCÜ\tTUR\tALT SINIR\tSÜRE\tAYIRMA\tBAYT\tYOL\t",
+	"Solution is valid: %d ants, %d turns (lower bound: %d, gap: %d)": "Çözüm geçerli: %d karınca, %d tur (alt sınır: %d, fark: %d)",
+
+	// Bayrak açıklamaları
+	"detailed report (rooms, links, step numbers and elapsed time)": "ayrıntılı rapor (odalar, bağlantılar, adım numaraları ve süre)",
+	"path solver: ": "yol çözücüsü: ",
+	"at most one ant may use each link per turn":                          "her bağlantıdan bir turda en fazla bir karınca geçebilir",
+	"animate the map and the ants in the terminal":                        "haritayı ve karıncaların hareketini terminalde canlandır",
+	"delay between turns in --visual mode":                                "--visual modunda turlar arasındaki bekleme",
+	"wait for Enter after every turn in --visual mode":                    "--visual modunda her turdan sonra Enter tuşunu bekle",
+	"write an HTML page that replays the moves in a browser to this file": "hamleleri tarayıcıda oynatan HTML sayfasının yazılacağı dosya",
+	"time limit for choosing the paths (0: no limit)":                     "yol seçimi için süre sınırı (0: sınırsız)",
+	"output format: text or json":                                         "çıktı biçimi: text veya json",
+	"message language: en or tr (default from LANG)":                      "mesaj dili: en veya tr (varsayılan LANG'den)",
+	"solvers to compare (comma separated)":                                "karşılaştırılacak çözücüler (virgülle ayrılmış)",
+	"time limit for each solver":                                          "her çözücü için en fazla süre",
+	"file to write the SVG image to":                                      "SVG çıktısının yazılacağı dosya",
+	"file to write the PNG image to":                                      "PNG çıktısının yazılacağı dosya",
+	"file to write the GraphViz DOT graph to":                             "GraphViz DOT çıktısının yazılacağı dosya",
+	"map type: ": "harita türü: ",
+	"map size (number of rooms, side length or number of paths, depending on the type)": "haritanın boyutu (türe göre oda sayısı, kenar uzunluğu veya yol sayısı)",
+	"number of ants":               "karınca sayısı",
+	"random number generator seed": "rastgele sayı üreteci tohumu",
+
+	// Kullanım hataları
+	"flag provided but not defined: ":     "tanımsız bayrak: ",
+	"flag needs an argument: ":            "bayrağın değeri eksik: ",
+	"bad flag syntax: ":                   "hatalı bayrak sözdizimi: ",
+	"Usage of %s:":                        "%s kullanımı:",
+	" (default %s)":                       " (varsayılan %s)",
+	"ERROR:":                              "HATA:",
+	"unknown output format: %s":           "bilinmeyen çıktı biçimi: %s",
+	"more than one file name given":       "birden fazla dosya adı belirtildi",
+	"the number of ants must be positive": "karınca sayısı pozitif olmalı",
+	"usage: lem-in check [-one-ant-per-tunnel] map.txt solution.txt":                           "kullanım: lem-in check [-one-ant-per-tunnel] harita.txt cozum.txt",
+	"usage: lem-in bench [-solvers=a,b] [-timeout=10s] directory":                              "kullanım: lem-in bench [-solvers=a,b] [-timeout=10s] klasör",
+	"usage: lem-in render [-svg=map.svg] [-png=map.png] [-dot=map.dot] [-solver=name] map.txt": "kullanım: lem-in render [-svg=harita.svg] [-png=harita.png] [-dot=harita.dot] [-solver=ad] harita.txt",
+
+	// Hatalar
+	"line %d %q: %s":            "%d. satır %q: %s",
+	"turn %d %q: %s":            "%d. tur %q: %s",
+	"cannot open %s: %v":        "%s açılamadı: %v",
+	"invalid number of ants":    "geçersiz karınca sayısı",
+	"no path from start to end": "başlangıçtan bitişe yol yok",
+	"the solver timed out":      "çözücü zaman aşımına uğradı",
+	"the simulation stalled before every ant reached the end": "simülasyon bütün karıncalar bitişe ulaşmadan takıldı",
+	"unknown solver": "bilinmeyen çözücü",
+	"a room with the same name is already defined":                 "aynı isimde oda zaten tanımlı",
+	"a room with the same coordinates is already defined":          "aynı koordinatlarda oda zaten tanımlı",
+	"room names cannot start with 'L' or '#'":                      "oda ismi 'L' veya '#' ile başlayamaz",
+	"a room cannot be linked to itself":                            "oda kendisine bağlanamaz",
+	"link is already defined":                                      "bağlantı zaten tanımlı",
+	"more than one ##start command":                                "birden fazla ##start komutu",
+	"more than one ##end command":                                  "birden fazla ##end komutu",
+	"room defined after the links":                                 "oda bağlantılardan sonra tanımlanmış",
+	"line is neither a room nor a link":                            "satır oda veya bağlantı olarak yorumlanamadı",
+	"no such ant":                                                  "böyle bir karınca yok",
+	"ant moved twice in the same turn":                             "karınca aynı turda iki kez hareket etti",
+	"ant moved after reaching the end":                             "karınca bitişe ulaştıktan sonra hareket etti",
+	"the rooms are not linked":                                     "odalar arasında bağlantı yok",
+	"more ants in the room than it can hold":                       "odada kapasitesinden fazla karınca var",
+	"not all ants reached the end":                                 "bütün karıncalar bitişe ulaşmadı",
+	"more ants entered the link in the same turn than it can hold": "bağlantıya aynı turda kapasitesinden fazla karınca girdi",
+	"the ant arrived before it could cross the link":               "karınca bağlantıyı geçmeden odaya vardı",
+	"invalid room definition":                                      "geçersiz oda tanımı",
+	"room capacity must be a positive integer":                     "oda kapasitesi pozitif bir tam sayı olmalı",
+	"invalid link definition":                                      "geçersiz bağlantı tanımı",
+	"link capacity and length must be positive integers":           "bağlantı kapasitesi ve süresi pozitif tam sayı olmalı",
+	"link refers to an unknown room":                               "bağlantıda tanımsız oda",
+	"no start room given":                                          "başlangıç odası belirtilmedi",
+	"no end room given":                                            "bitiş odası belirtilmedi",
+	"no room definition after the command":                         "komuttan sonra oda tanımı yok",
+	"invalid or unsupported DOT syntax":                            "geçersiz veya desteklenmeyen DOT sözdizimi",
+	"invalid move, expected \"Lx-room\"":                           "geçersiz hamle, \"Lx-oda\" bekleniyordu",
+	"move refers to an unknown room":                               "hamlede tanımsız oda",
+	"unknown map type":                                             "bilinmeyen harita türü",
+	"invalid size":                                                 "geçersiz boyut",
+}
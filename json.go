@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"main.go/lemin"
+)
+
+// jsonRoom, jsonLink, jsonMove ve jsonOutput, --format=json çıktısının yapısıdır.
+type jsonRoom struct {
+	Name     string `json:"name"`
+	X        int    `json:"x"`
+	Y        int    `json:"y"`
+	Capacity int    `json:"capacity,omitempty"`
+	Start    bool   `json:"start,omitempty"`
+	End      bool   `json:"end,omitempty"`
+}
+
+type jsonLink struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Capacity int    `json:"capacity,omitempty"`
+	Length   int    `json:"length,omitempty"`
+}
+
+type jsonMove struct {
+	Ant  int    `json:"ant"`
+	Room string `json:"room"`
+}
+
+type jsonStats struct {
+	Solver     string `json:"solver"`
+	Turns      int    `json:"turns"`
+	LowerBound int    `json:"lower_bound"`
+	Explored   int    `json:"explored"`
+}
+
+type jsonOutput struct {
+	Ants     int          `json:"ants"`
+	Rooms    []jsonRoom   `json:"rooms"`
+	Links    []jsonLink   `json:"links"`
+	Paths    [][]string   `json:"paths"`
+	PathAnts []int        `json:"path_ants"`
+	Turns    [][]jsonMove `json:"turns"`
+	Stats    jsonStats    `json:"stats"`
+}
+
+// writeJSON, haritayı, seçilen yolları (oda isimleriyle), her yola atanan karınca sayısını ve tur
+// tur hamleleri tek bir JSON belgesi olarak w'ye yazar.
+func writeJSON(w io.Writer, graph *lemin.Graph, antCount int, solution *lemin.Solution, solver string, rules lemin.Rules) error {
+	output := jsonOutput{
+		Ants:     antCount,
+		Rooms:    make([]jsonRoom, len(graph.Nodes)),
+		Links:    make([]jsonLink, len(graph.Edges)),
+		Paths:    make([][]string, len(solution.Paths)),
+		PathAnts: solution.AntCounts,
+		Turns:    make([][]jsonMove, len(solution.Turns)),
+		Stats: jsonStats{
+			Solver:     solver,
+			Turns:      len(solution.Turns),
+			LowerBound: graph.LowerBound(antCount, rules),
+			Explored:   solution.Explored,
+		},
+	}
+	for i, node := range graph.Nodes {
+		output.Rooms[i] = jsonRoom{
+			Name:     node.Name,
+			X:        node.X,
+			Y:        node.Y,
+			Capacity: node.Capacity,
+			Start:    node.ID == graph.StartNodeID,
+			End:      node.ID == graph.EndNodeID,
+		}
+	}
+	for i, edge := range graph.Edges {
+		output.Links[i] = jsonLink{
+			From:     graph.Nodes[edge.Start].Name,
+			To:       graph.Nodes[edge.End].Name,
+			Capacity: edge.Capacity,
+			Length:   edge.Length,
+		}
+	}
+	for i, path := range solution.Paths {
+		output.Paths[i] = make([]string, len(path))
+		for j, nodeID := range path {
+			output.Paths[i][j] = graph.Nodes[nodeID].Name
+		}
+	}
+	for t, turn := range solution.Turns {
+		output.Turns[t] = make([]jsonMove, len(turn))
+		for i, move := range turn {
+			output.Turns[t][i] = jsonMove{Ant: move.Ant, Room: graph.Nodes[move.Room].Name}
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
@@ -0,0 +1,75 @@
+package lemin
+
+// DistributeAnts, antCount karıncayı yollara tüm yollar aynı turda bitecek şekilde dağıtır.
+// Her karınca, kenar sayısı ile üzerindeki karınca sayısının toplamı en küçük olan yola eklenir;
+// böylece kısa yollar daha fazla, uzun yollar daha az karınca alır. Her yol için karınca sayısını
+// ve son karıncanın bitişe ulaştığı turu döndürür. Hesap, her bağlantıdan bir turda bir karınca
+// geçtiğini varsayar (Rules.OneAntPerTunnel); kuralın kapalı olduğu durum için Graph.DistributeAnts
+// kullanılmalıdır.
+func DistributeAnts(paths [][]int, antCount int) ([]int, int) {
+	lengths := make([]int, len(paths))
+	for i, path := range paths {
+		lengths[i] = len(path) - 1
+	}
+	return distribute(lengths, make([]bool, len(paths)), antCount)
+}
+
+// DistributeAnts, DistributeAnts fonksiyonu gibi çalışır; yolların uzunluğu olarak kenar sayısı
+// yerine bağlantı sürelerinin toplamını (PathLength) kullanır ve rules kurallarını hesaba katar:
+// OneAntPerTunnel kapalıyken kapasitesiz, doğrudan başlangıçtan bitişe giden bir bağlantı bütün
+// karıncalarını aynı turda geçirir.
+func (g *Graph) DistributeAnts(paths [][]int, antCount int, rules Rules) ([]int, int) {
+	lengths := make([]int, len(paths))
+	unlimited := make([]bool, len(paths))
+	for i, path := range paths {
+		lengths[i] = g.PathLength(path)
+		unlimited[i] = g.unlimitedPath(path, rules)
+	}
+	return distribute(lengths, unlimited, antCount)
+}
+
+// unlimitedPath, yolun bir turda sınırsız sayıda karınca taşıyıp taşımadığını döndürür. Bu yalnızca
+// başlangıçtan bitişe, rules ile sınırlanmamış doğrudan bir bağlantı için doğrudur.
+func (g *Graph) unlimitedPath(path []int, rules Rules) bool {
+	return len(path) == 2 && path[0] == g.StartNodeID && path[1] == g.EndNodeID &&
+		g.tunnelLimit(path[0], path[1], rules) == infinity
+}
+
+// distribute, uzunlukları verilen yollara karıncaları dağıtır. unlimited işaretli yollarda
+// karıncalar sıra beklemez; hepsi yolun uzunluğu kadar turda biter.
+func distribute(lengths []int, unlimited []bool, antCount int) ([]int, int) {
+	counts := make([]int, len(lengths)) // Her yola atanan karınca sayısı
+	if len(lengths) == 0 {
+		return counts, 0
+	}
+
+	// finish, yola bir karınca daha eklenirse o karıncanın bitişe ulaşacağı turdur.
+	finish := func(i int) int {
+		if unlimited[i] {
+			return lengths[i]
+		}
+		return lengths[i] + counts[i]
+	}
+	for ant := 0; ant < antCount; ant++ {
+		best := 0
+		for i := range lengths {
+			if finish(i) < finish(best) {
+				best = i
+			}
+		}
+		counts[best]++
+	}
+
+	// Bir yolun uzunluğu e turdaysa ve üzerinde k karınca varsa, son karınca e + k - 1. turda bitişe ulaşır.
+	turns := 0
+	for i, length := range lengths {
+		last := length + counts[i] - 1
+		if unlimited[i] {
+			last = length
+		}
+		if counts[i] > 0 && last > turns {
+			turns = last
+		}
+	}
+	return counts, turns
+}
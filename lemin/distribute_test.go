@@ -0,0 +1,72 @@
+package lemin_test
+
+import (
+	"reflect"
+	"testing"
+
+	"main.go/lemin"
+)
+
+// path, verilen sayıda bağlantısı olan bir yol döndürür; odaların ID'leri dağıtımda önemli değildir.
+func path(links int) []int {
+	return make([]int, links+1)
+}
+
+func TestDistributeAnts(t *testing.T) {
+	tests := []struct {
+		name   string
+		paths  [][]int
+		ants   int
+		counts []int
+		turns  int
+	}{
+		{"yol yok", nil, 5, []int{}, 0},
+		{"tek yol", [][]int{path(3)}, 4, []int{4}, 6},
+		{"eşit yollar", [][]int{path(2), path(2)}, 5, []int{3, 2}, 4},
+		{"kısa ve uzun yol", [][]int{path(2), path(4)}, 9, []int{6, 3}, 7},
+		{"kullanılmayan uzun yol", [][]int{path(2), path(10)}, 3, []int{3, 0}, 4},
+		{"tuzak haritası, az karınca", [][]int{path(4), path(4)}, 1, []int{1, 0}, 4},
+		{"tuzak haritası, çok karınca", [][]int{path(4), path(4)}, 20, []int{10, 10}, 13},
+	}
+	for _, test := range tests {
+		counts, turns := lemin.DistributeAnts(test.paths, test.ants)
+		if !reflect.DeepEqual(counts, test.counts) || turns != test.turns {
+			t.Errorf("%s: dağıtım %v, %d tur; beklenen %v, %d tur", test.name, counts, turns, test.counts, test.turns)
+		}
+	}
+}
+
+// Graph.DistributeAnts bağlantı sürelerini ve kuralları hesaba katar.
+func TestGraphDistributeAnts(t *testing.T) {
+	graph, ants := parseMap(t, `6
+##start
+s 0 0
+a 1 0
+b 1 1
+##end
+e 2 0
+s-e
+s-a::3
+a-e
+s-b
+b-e
+`)
+	s, a, b, e := graph.StartNodeID, lemin.FindNodeIDByName(graph.Nodes, "a"), lemin.FindNodeIDByName(graph.Nodes, "b"), graph.EndNodeID
+	tests := []struct {
+		name   string
+		paths  [][]int
+		rules  lemin.Rules
+		counts []int
+		turns  int
+	}{
+		{"uzun bağlantı", [][]int{{s, b, e}, {s, a, e}}, lemin.Rules{}, []int{4, 2}, 5},
+		{"doğrudan bağlantı", [][]int{{s, e}, {s, b, e}}, lemin.Rules{}, []int{6, 0}, 1},
+		{"doğrudan bağlantı, bağlantı başına bir karınca", [][]int{{s, e}, {s, b, e}}, lemin.Rules{OneAntPerTunnel: true}, []int{4, 2}, 4},
+	}
+	for _, test := range tests {
+		counts, turns := graph.DistributeAnts(test.paths, ants, test.rules)
+		if !reflect.DeepEqual(counts, test.counts) || turns != test.turns {
+			t.Errorf("%s: dağıtım %v, %d tur; beklenen %v, %d tur", test.name, counts, turns, test.counts, test.turns)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package lemin
+
+import "container/heap"
+
+// flowEdge, artık (residual) ağdaki yönlü bir kenarı temsil eder.
+type flowEdge struct {
+	To   int // Kenarın vardığı ağ düğümü
+	Rev  int // Ters kenarın, To düğümünün kenar listesindeki indeksi
+	Cap  int // Kenarın kalan kapasitesi
+	Orig int // Kenarın ilk kapasitesi (ters kenarlar için 0)
+	Cost int // Kenardan bir birim akış geçirmenin maliyeti (ters kenarlar için eksi değeri)
+}
+
+// flowNetwork, her odanın giriş ve çıkış olarak ikiye bölündüğü akış ağıdır.
+// Oda v için giriş düğümü 2*v, çıkış düğümü 2*v+1 olur; aradaki kenarın kapasitesi odanın
+// kapasitesi olduğu için bir odadan en fazla kapasitesi kadar yol geçebilir (başlangıç ve bitiş
+// odaları hariç). Bir bağlantının kapasitesi iki ucundaki odaların kapasitesi ile bağlantıya bir
+// turda girebilecek karınca sayısının (tunnelLimit) küçüğüdür; kapasitesi birden büyük odalar ve
+// bağlantılar üzerinden aynı yol birden fazla kez seçilebilir.
+type flowNetwork struct {
+	adj [][]flowEdge
+}
+
+func inNode(id int) int  { return 2 * id }
+func outNode(id int) int { return 2*id + 1 }
+
+// addEdge, from'dan to'ya verilen kapasite ve maliyette bir kenar ile kapasitesi 0 olan ters kenarını ekler.
+func (n *flowNetwork) addEdge(from, to, capacity, cost int) {
+	n.adj[from] = append(n.adj[from], flowEdge{To: to, Rev: len(n.adj[to]), Cap: capacity, Orig: capacity, Cost: cost})
+	n.adj[to] = append(n.adj[to], flowEdge{To: from, Rev: len(n.adj[from]) - 1, Cost: -cost})
+}
+
+// newFlowNetwork, grafın rules kurallarına göre düğüm bölünmüş akış ağını oluşturur. Odalar arası
+// her bağlantının maliyeti geçiş süresi, oda içi kenarların maliyeti 0'dır; böylece bir yolun
+// maliyeti PathLength'e eşit olur.
+func (g *Graph) newFlowNetwork(startNodeID int, endNodeID int, rules Rules) *flowNetwork {
+	n := &flowNetwork{adj: make([][]flowEdge, 2*len(g.Nodes))}
+	for _, node := range g.Nodes {
+		capacity := g.RoomCapacity(node.ID)
+		if node.ID == startNodeID || node.ID == endNodeID {
+			capacity = len(g.Nodes) // Başlangıç ve bitiş odaları sınırsız sayıda yol taşıyabilir
+		}
+		n.addEdge(inNode(node.ID), outNode(node.ID), capacity, 0)
+	}
+	for _, node := range g.Nodes {
+		for _, neighbor := range g.AdjList[node.ID] {
+			capacity := g.RoomCapacity(node.ID)
+			if c := g.RoomCapacity(neighbor); c < capacity {
+				capacity = c
+			}
+			if limit := g.tunnelLimit(node.ID, neighbor, rules); limit < capacity {
+				capacity = limit
+			}
+			if capacity == infinity {
+				capacity = 1 // Başlangıçtan bitişe kapasitesiz doğrudan bağlantı tek yol sayılır.
+			}
+			n.addEdge(outNode(node.ID), inNode(neighbor), capacity, g.LinkLength(node.ID, neighbor))
+		}
+	}
+	return n
+}
+
+// augment, artık ağda BFS ile en kısa artırıcı yolu bulur ve akışı bir birim artırır.
+// Artırıcı yol bulunamazsa false döndürür.
+func (n *flowNetwork) augment(source int, sink int) bool {
+	// prevNode ve prevEdge, BFS ağacında her düğüme hangi kenarla gelindiğini saklar.
+	prevNode := make([]int, len(n.adj))
+	prevEdge := make([]int, len(n.adj))
+	for i := range prevNode {
+		prevNode[i] = -1
+	}
+	prevNode[source] = source
+
+	queue := []int{source}
+	for len(queue) > 0 && prevNode[sink] == -1 {
+		current := queue[0]
+		queue = queue[1:]
+		for i, edge := range n.adj[current] {
+			if edge.Cap > 0 && prevNode[edge.To] == -1 {
+				prevNode[edge.To] = current
+				prevEdge[edge.To] = i
+				queue = append(queue, edge.To)
+			}
+		}
+	}
+
+	if prevNode[sink] == -1 {
+		return false
+	}
+	n.push(source, sink, prevNode, prevEdge)
+	return true
+}
+
+// push, prevNode/prevEdge ile tarif edilen artırıcı yol boyunca bir birim akış geçirir.
+func (n *flowNetwork) push(source int, sink int, prevNode []int, prevEdge []int) {
+	// Bitişten başlangıca geri yürüyerek yol üzerindeki kapasiteleri güncelle.
+	for v := sink; v != source; v = prevNode[v] {
+		edge := &n.adj[prevNode[v]][prevEdge[v]]
+		edge.Cap--
+		n.adj[v][edge.Rev].Cap++
+	}
+}
+
+// paths, mevcut akışı başlangıçtan bitişe giden oda ID'si dizilerine çevirir.
+func (n *flowNetwork) paths(startNodeID int, endNodeID int) [][]int {
+	// used, her kenardan okunmuş akış miktarını tutar; böylece aynı akış iki kez okunmaz.
+	used := make(map[[2]int]int)
+	paths := [][]int{}
+
+	for {
+		path := []int{startNodeID}
+		current := startNodeID
+		for current != endNodeID {
+			next := -1
+			for i, edge := range n.adj[outNode(current)] {
+				// Yalnızca okunmamış akışı kalan ileri kenarlar.
+				if edge.Orig == 0 || used[[2]int{outNode(current), i}] >= edge.Orig-edge.Cap {
+					continue
+				}
+				used[[2]int{outNode(current), i}]++
+				next = edge.To / 2
+				break
+			}
+			if next == -1 {
+				return paths
+			}
+			// Akış içinde bir döngü varsa, yola tekrar gelinen düğümden sonrasını at.
+			if i := indexOf(path, next); i != -1 {
+				path = path[:i]
+			}
+			path = append(path, next)
+			current = next
+		}
+		paths = append(paths, path)
+	}
+}
+
+// MaxFlowPaths, startNodeID'den endNodeID'ye giden ve rules kurallarıyla bir turda birlikte
+// kullanılabilecek en fazla sayıda yolu Edmonds-Karp algoritmasıyla polinom zamanda bulur.
+func (g *Graph) MaxFlowPaths(startNodeID int, endNodeID int, rules Rules) [][]int {
+	if startNodeID == endNodeID {
+		return [][]int{{startNodeID}}
+	}
+	n := g.newFlowNetwork(startNodeID, endNodeID, rules)
+	for n.augment(outNode(startNodeID), inNode(endNodeID)) {
+	}
+	return n.paths(startNodeID, endNodeID)
+}
+
+// LowerBound, antCount karıncanın bitişe ulaşması için gereken tur sayısının teorik alt sınırını
+// hesaplar. Maksimum akış değeri F ise her turda en fazla F karınca en küçük kesimi geçebilir; bu
+// yüzden son karınca en erken ⌈antCount / F⌉. turda yola çıkar ve en az en kısa yolun süresi kadar
+// yürür. OneAntPerTunnel kapalıyken başlangıçtan bitişe kapasitesiz doğrudan bir bağlantı varsa
+// bütün karıncalar aynı turda yola çıkabilir; alt sınır en kısa yolun süresidir. Yol yoksa -1
+// döndürür.
+func (g *Graph) LowerBound(antCount int, rules Rules) int {
+	shortest := g.shortestLength(g.StartNodeID, g.EndNodeID)
+	if shortest == infinity {
+		return -1
+	}
+	if contains(g.AdjList[g.StartNodeID], g.EndNodeID) && g.unlimitedPath([]int{g.StartNodeID, g.EndNodeID}, rules) {
+		return shortest
+	}
+	flow := len(g.MaxFlowPaths(g.StartNodeID, g.EndNodeID, rules))
+	return shortest + (antCount+flow-1)/flow - 1
+}
+
+// shortestLength, from'dan to'ya en kısa sürede giden yolun süresini bağlantı sürelerini ağırlık
+// alarak Dijkstra ile bulur. Yol yoksa infinity döndürür.
+func (g *Graph) shortestLength(from int, to int) int {
+	dist := map[int]int{from: 0}
+	queue := &distQueue{{node: from, dist: 0}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(distItem)
+		if item.node == to {
+			return item.dist
+		}
+		if item.dist > dist[item.node] {
+			continue
+		}
+		for _, neighbor := range g.AdjList[item.node] {
+			d := item.dist + g.LinkLength(item.node, neighbor)
+			if current, seen := dist[neighbor]; !seen || d < current {
+				dist[neighbor] = d
+				heap.Push(queue, distItem{node: neighbor, dist: d})
+			}
+		}
+	}
+	return infinity
+}
+
+// BestPaths, her artırımdan sonra oluşan ayrık yol kümesini değerlendirir ve antCount karınca
+// için rules kurallarıyla en az turda biten kümeyi döndürür. Az karınca için tek bir kısa yol, çok
+// karınca için daha fazla (ama daha uzun) yol seçilebilir.
+func (g *Graph) BestPaths(startNodeID int, endNodeID int, antCount int, rules Rules) [][]int {
+	paths, _ := g.bestPaths(startNodeID, endNodeID, antCount, rules, (*flowNetwork).augment, nil)
+	return paths
+}
+
+// bestPaths, rules kurallarına göre kurulan akış ağında verilen artırma fonksiyonuyla akışı birer
+// birim artırır ve her artırımdan sonra antCount karınca için rules kurallarıyla en az turu veren
+// yol kümesini saklar. Yol kümesiyle birlikte bulunan artırıcı yol sayısını da döndürür. done
+// kapatılırsa artırmayı bırakır.
+func (g *Graph) bestPaths(startNodeID int, endNodeID int, antCount int, rules Rules, augment func(n *flowNetwork, source int, sink int) bool, done <-chan struct{}) ([][]int, int) {
+	if startNodeID == endNodeID {
+		return [][]int{{startNodeID}}, 1
+	}
+	var bestPaths [][]int
+	bestTurns := -1
+	augmentations := 0
+
+	n := g.newFlowNetwork(startNodeID, endNodeID, rules)
+	for !cancelled(done) && augment(n, outNode(startNodeID), inNode(endNodeID)) {
+		augmentations++
+		paths := n.paths(startNodeID, endNodeID)
+		_, turns := g.DistributeAnts(paths, antCount, rules)
+		if bestTurns == -1 || turns < bestTurns {
+			bestPaths, bestTurns = paths, turns
+		}
+	}
+	return bestPaths, augmentations
+}
+
+// ShortestPath, blocked içinde işaretli düğümlere uğramadan from'dan to'ya giden en kısa yolu
+// BFS ile bulur. from düğümü de engelliyse veya yol yoksa nil döndürür.
+func (g *Graph) ShortestPath(from int, to int, blocked map[int]bool) []int {
+	if blocked[from] {
+		return nil
+	}
+	prev := map[int]int{from: from}
+	queue := []int{from}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node == to {
+			path := []int{}
+			for v := to; v != from; v = prev[v] {
+				path = append([]int{v}, path...)
+			}
+			return append([]int{from}, path...)
+		}
+		for _, neighbor := range g.AdjList[node] {
+			if _, seen := prev[neighbor]; !seen && !blocked[neighbor] {
+				prev[neighbor] = node
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return nil
+}
+
+// indexOf, item'ın slice içindeki ilk indeksini döndürür; bulunamazsa -1 döndürür.
+func indexOf(slice []int, item int) int {
+	for i, v := range slice {
+		if v == item {
+			return i
+		}
+	}
+	return -1
+}
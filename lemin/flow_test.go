@@ -0,0 +1,145 @@
+package lemin_test
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"main.go/generator"
+	"main.go/lemin"
+	"main.go/parser"
+)
+
+// trapMap, başlangıçtan bitişe iki uzun yol ve bu yolları birbirine bağlayan bir kısayol içerir. En
+// kısa yol kısayolu kullanır ve iki uzun yolu birden tıkar.
+const trapMap = `%d
+##start
+s 0 1
+t1 1 0
+t2 2 0
+t3 3 0
+u1 1 2
+u2 2 2
+u3 3 2
+##end
+e 4 1
+s-t1
+t1-t2
+t2-t3
+t3-e
+s-u1
+u1-u2
+u2-u3
+u3-e
+t1-u3
+`
+
+// Örnek haritaların bütün çözücülerle ve iki kuralla kaç turda çözüldüğü.
+func TestSolveExamples(t *testing.T) {
+	tests := []struct {
+		file            string
+		turns           int // Kurallar kapalıyken
+		oneAntPerTunnel int // OneAntPerTunnel açıkken
+	}{
+		{"example00.txt", 6, 6},
+		{"example01.txt", 8, 8},
+		{"example02.txt", 1, 11},
+		{"example03.txt", 6, 6},
+		{"example04.txt", 6, 6},
+		{"example05.txt", 8, 8},
+		{"example06.txt", 102, 102},
+		{"example07.txt", 502, 502},
+	}
+	for _, test := range tests {
+		file, err := os.Open("../" + test.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		graph, ants, err := parser.Parse(file)
+		file.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", test.file, err)
+		}
+		for _, name := range lemin.SolverNames() {
+			for rules, turns := range map[lemin.Rules]int{{}: test.turns, {OneAntPerTunnel: true}: test.oneAntPerTunnel} {
+				solution, err := lemin.Solve(graph, ants, lemin.Options{Solver: name, Verify: true, Rules: rules})
+				if err != nil {
+					t.Errorf("%s %s %+v: %v", test.file, name, rules, err)
+					continue
+				}
+				if len(solution.Turns) != turns {
+					t.Errorf("%s %s %+v: %d tur, beklenen %d", test.file, name, rules, len(solution.Turns), turns)
+				}
+				if lowerBound := graph.LowerBound(ants, rules); lowerBound > turns {
+					t.Errorf("%s %+v: alt sınır %d, tur sayısından büyük", test.file, rules, lowerBound)
+				}
+			}
+		}
+	}
+}
+
+// Küçük haritalarda bütün çözücüler aynı tur sayısını bulmalıdır. bfs bütün yolları listelediği için
+// haritalar küçük tutulur.
+func TestSolversAgree(t *testing.T) {
+	for _, kind := range []string{"random", "grid", "corridor", "parallel"} {
+		for size := 2; size <= 4; size++ {
+			for seed := int64(1); seed <= 5; seed++ {
+				graph, err := generator.Generate(generator.Options{Kind: kind, Size: size, Seed: seed})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ants := int(seed) * 4
+				turns := map[string]int{}
+				for _, name := range lemin.SolverNames() {
+					solution, err := lemin.Solve(graph, ants, lemin.Options{Solver: name, Verify: true})
+					if err != nil {
+						t.Fatalf("%s %d %d %s: %v", kind, size, seed, name, err)
+					}
+					turns[name] = len(solution.Turns)
+				}
+				for _, name := range lemin.SolverNames() {
+					if turns[name] != turns[lemin.DefaultSolver] {
+						t.Errorf("%s %d %d: çözücüler farklı tur sayıları buldu: %v", kind, size, seed, turns)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+// Az karınca için kısayol, çok karınca için kısayolun tıkadığı iki uzun yol seçilmelidir.
+func TestBestPathsTrap(t *testing.T) {
+	tests := []struct {
+		ants   int
+		paths  [][]string
+		counts []int
+		turns  int
+	}{
+		{1, [][]string{{"s", "t1", "u3", "e"}}, []int{1}, 3},
+		{2, [][]string{{"s", "t1", "u3", "e"}}, []int{2}, 4},
+		{20, [][]string{{"s", "t1", "t2", "t3", "e"}, {"s", "u1", "u2", "u3", "e"}}, []int{10, 10}, 13},
+	}
+	for _, test := range tests {
+		graph, ants := parseMap(t, fmt.Sprintf(trapMap, test.ants))
+		if flow := len(graph.MaxFlowPaths(graph.StartNodeID, graph.EndNodeID, lemin.Rules{})); flow != 2 {
+			t.Errorf("maksimum akış %d, beklenen 2", flow)
+		}
+		for _, name := range []string{"edmonds-karp", "suurballe", "min-cost-flow"} {
+			solution, err := lemin.Solve(graph, ants, lemin.Options{Solver: name, Verify: true})
+			if err != nil {
+				t.Fatalf("%s %d karınca: %v", name, ants, err)
+			}
+			paths := make([][]string, len(solution.Paths))
+			for i, path := range solution.Paths {
+				for _, id := range path {
+					paths[i] = append(paths[i], graph.Nodes[id].Name)
+				}
+			}
+			if !reflect.DeepEqual(paths, test.paths) || !reflect.DeepEqual(solution.AntCounts, test.counts) || len(solution.Turns) != test.turns {
+				t.Errorf("%s %d karınca: yollar %v, dağıtım %v, %d tur; beklenen %v, %v, %d tur", name, ants, paths, solution.AntCounts, len(solution.Turns), test.paths, test.counts, test.turns)
+			}
+		}
+	}
+}
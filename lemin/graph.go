@@ -0,0 +1,256 @@
+// Package lemin, karınca kolonisi haritasının graf yapısını ve yol bulma algoritmalarını içerir.
+package lemin
+
+// Node, haritadaki bir odayı temsil eder.
+type Node struct {
+	ID   int    // Node ID
+	Name string // Node Name
+	X    int    // X coordinate
+	Y    int    // Y coordinate
+	Line int    // Haritada tanımlandığı satır (haritadan okunmadıysa 0)
+
+	Capacity int // Odada aynı anda bulunabilecek en fazla karınca sayısı (0 ise 1)
+}
+
+// Edge, iki oda arasındaki bir bağlantıyı (tüneli) temsil eder.
+type Edge struct {
+	Start int // Starting node ID of the edge
+	End   int // Ending node ID of the edge
+	Line  int // Haritada tanımlandığı satır (haritadan okunmadıysa 0)
+
+	Capacity int // Bir turda bağlantıya girebilecek en fazla karınca sayısı (0 ise belirtilmemiş)
+	Length   int // Bağlantıdan geçmenin sürdüğü tur sayısı (0 ise 1)
+}
+
+// SourceLine, haritadaki bir satırı numarasıyla birlikte tutar.
+type SourceLine struct {
+	Line int    // Satır numarası (1'den başlar)
+	Text string // Satırın metni
+}
+
+// Graph, odaları, bağlantıları ve başlangıç/bitiş odalarını tutar.
+type Graph struct {
+	Nodes       []Node        // List of nodes in the graph
+	Edges       []Edge        // List of edges in the graph
+	StartNodeID int           // ID of the start node
+	EndNodeID   int           // ID of the end node
+	AdjList     map[int][]int // Adjacency list representing the graph
+	StartLines  []int         // ##start komutlarının geçtiği satırlar
+	EndLines    []int         // ##end komutlarının geçtiği satırlar
+	Unparsed    []SourceLine  // Oda veya bağlantı olarak yorumlanamayan satırlar
+
+	links map[[2]int]int // tunnelKey ile her bağlantının Edges içindeki indeksi
+}
+
+// NewGraph, başlangıç ve bitiş odaları henüz belirlenmemiş boş bir graf oluşturur.
+func NewGraph() *Graph {
+	return &Graph{
+		AdjList:     make(map[int][]int), // Düğümlerin komşuluk ilişkilerini depolamak için bir harita oluşturulur.
+		StartNodeID: -1,                  // Başlangıç düğümünün ID'si -1 olarak başlatılır (bu değer daha sonra belirlenecektir).
+		EndNodeID:   -1,                  // Bitiş düğümünün ID'si -1 olarak başlatılır (bu değer daha sonra belirlenecektir).
+	}
+}
+
+// AddNode, grafa yeni bir oda ekler ve odanın ID'sini döndürür.
+func (g *Graph) AddNode(name string, x int, y int) int {
+	id := len(g.Nodes) // Düğümün ID'sini belirle
+	g.Nodes = append(g.Nodes, Node{ID: id, Name: name, X: x, Y: y})
+	return id
+}
+
+// AddEdge, iki oda arasına çift yönlü bir bağlantı ekler.
+func (g *Graph) AddEdge(startID int, endID int) {
+	if g.links == nil {
+		g.links = make(map[[2]int]int)
+	}
+	if _, found := g.links[tunnelKey(startID, endID)]; !found {
+		g.links[tunnelKey(startID, endID)] = len(g.Edges) // Tekrar eden bağlantılarda ilki kullanılır
+	}
+	g.Edges = append(g.Edges, Edge{Start: startID, End: endID}) // Kenarı graf kenarlarına ekle
+	g.AdjList[startID] = append(g.AdjList[startID], endID)      // Başlangıç düğümünün komşuları listesine bitiş düğümünü ekle
+	g.AdjList[endID] = append(g.AdjList[endID], startID)        // Bitiş düğümünün komşuları listesine başlangıç düğümünü ekle
+}
+
+// RoomCapacity, odada aynı anda bulunabilecek en fazla karınca sayısını döndürür. Başlangıç ve
+// bitiş odalarında sınır yoktur; kapasitesi belirtilmemiş odalara tek karınca sığar.
+func (g *Graph) RoomCapacity(id int) int {
+	if id == g.StartNodeID || id == g.EndNodeID {
+		return infinity
+	}
+	if g.Nodes[id].Capacity > 1 {
+		return g.Nodes[id].Capacity
+	}
+	return 1
+}
+
+// Link, a ile b odaları arasındaki bağlantıyı döndürür; bağlantı yoksa nil döndürür.
+func (g *Graph) Link(a int, b int) *Edge {
+	i, found := g.links[tunnelKey(a, b)]
+	if !found {
+		return nil
+	}
+	return &g.Edges[i]
+}
+
+// LinkLength, a ile b arasındaki bağlantıdan geçmenin kaç tur sürdüğünü döndürür; süresi
+// belirtilmemiş bağlantılar bir turda geçilir.
+func (g *Graph) LinkLength(a int, b int) int {
+	if edge := g.Link(a, b); edge != nil && edge.Length > 1 {
+		return edge.Length
+	}
+	return 1
+}
+
+// PathLength, yolun baştan sona kaç turda yürüneceğini, yani bağlantı sürelerinin toplamını döndürür.
+func (g *Graph) PathLength(path []int) int {
+	length := 0
+	for i := 1; i < len(path); i++ {
+		length += g.LinkLength(path[i-1], path[i])
+	}
+	return length
+}
+
+// Function to find the node ID by its name
+// Bir düğümün adını kullanarak düğüm ID'sini bulmak için bir fonksiyon
+func FindNodeIDByName(nodes []Node, name string) int {
+	// nodes slice'ındaki her bir node üzerinde döngü başlat
+	for _, node := range nodes {
+		// Eğer node'un adı verilen name'e eşitse
+		if node.Name == name {
+			// node.ID'yi döndür
+			return node.ID
+		}
+	}
+	// Eğer verilen name'e sahip bir node bulunamazsa, -1 döndür
+	return -1
+}
+
+// startNodeID'den endNodeID'ye kadar olan tüm yolları BFS kullanarak bulmak için bir fonksiyon
+func (g *Graph) BFSAllPaths(startNodeID int, endNodeID int) [][]int {
+	return g.bfsAllPaths(startNodeID, endNodeID, nil)
+}
+
+// bfsAllPaths, BFSAllPaths gibi çalışır; done kapatılırsa aramayı bırakır ve o ana kadar bulunan
+// yolları döndürür.
+func (g *Graph) bfsAllPaths(startNodeID int, endNodeID int, done <-chan struct{}) [][]int {
+	// Bulunan yolları saklamak için bir slice
+	paths := [][]int{}
+	// BFS kuyruğu, başlangıçta sadece başlangıç düğümünü içerir
+	queue := [][]int{{startNodeID}}
+
+	// Kuyruk boşalana (veya arama iptal edilene) kadar devam et
+	for len(queue) > 0 && !cancelled(done) {
+		// Kuyruğun ilk yolunu al ve kuyruktan çıkar
+		path := queue[0]
+		queue = queue[1:]
+		// Yolun son düğümünü al
+		node := path[len(path)-1]
+
+		// Eğer son düğüm bitiş düğümü ise, bu yolu sonuçlara ekle
+		if node == endNodeID {
+			paths = append(paths, path)
+			continue
+		}
+
+		// Son düğümün komşularını kontrol et
+		for _, neighbor := range g.AdjList[node] {
+			// Eğer komşu zaten bu yolun içinde değilse, yeni bir yol oluştur
+			if !contains(path, neighbor) {
+				// Mevcut yolu kopyala
+				newPath := append([]int{}, path...)
+				// Yeni yolu komşu ile genişlet
+				newPath = append(newPath, neighbor)
+				// Yeni yolu kuyruğa ekle
+				queue = append(queue, newPath)
+			}
+		}
+	}
+
+	// Bulunan tüm yolları geri döndür
+	return paths
+}
+
+func contains(slice []int, item int) bool {
+	for _, v := range slice {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
+
+// cancelled, done kapatıldıysa true döndürür; nil done hiçbir zaman kapanmaz.
+func cancelled(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+// FilterPaths, verilen yollar arasından düğüm çakışmalarını önleyerek en fazla sayıda yolu seçer.
+func FilterPaths(paths [][]int) [][]int {
+	return filterPaths(paths, nil)
+}
+
+// filterPaths, FilterPaths gibi çalışır; done kapatılırsa geriye izlemeyi bırakır ve o ana kadar
+// bulunan en iyi seçimi döndürür.
+func filterPaths(paths [][]int, done <-chan struct{}) [][]int {
+	// maxPaths, en fazla sayıda geçerli yolu saklar.
+	var maxPaths [][]int
+	// currentPaths, geçerli durumda incelenen yolları saklar.
+	var currentPaths [][]int
+	// usedNodes, kullanılan düğümleri izlemek için bir harita.
+	usedNodes := make(map[int]bool)
+
+	// backtrack, geriye izleme algoritması için iç içe bir fonksiyon olarak tanımlanır.
+	var backtrack func(int)
+	backtrack = func(start int) {
+		// Eğer geçerli yolların sayısı, maksimum yollardan fazlaysa, maxPaths güncellenir.
+		if len(currentPaths) > len(maxPaths) {
+			maxPaths = make([][]int, len(currentPaths)) //maxPaths değişkeni, currentPaths ile aynı sayıda elemana sahip boş bir slice'e dönüşüyor
+			//maxpaths değişkeni adında currentpaths uzunluğunda int değerinde değişken oluşturuyor
+			copy(maxPaths, currentPaths) //içerisine kopyalıyor
+		}
+
+		// Başlangıç indeksinden yolların sonuna kadar dolaş.
+		for i := start; i < len(paths) && !cancelled(done); i++ {
+			path := paths[i] //yolları tek tek path değişkenine atıyor
+			keepPath := true //kullanılma durumunu kontrol ediyor
+
+			// İlk ve son düğüm hariç, yolun düğümlerini kontrol et.
+			for _, node := range path[1 : len(path)-1] { //İlk ve son değişkenleri hariç bütün değişkenler alsın
+				// Eğer düğüm daha önce kullanıldıysa, bu yolu kullanma.
+				if usedNodes[node] {
+					keepPath = false
+					break
+				}
+			}
+
+			// Eğer yol geçerliyse (düğümler kullanılmamışsa), yollar listesine ekle.
+			if keepPath {
+				currentPaths = append(currentPaths, path)
+				// Kullanılan düğümleri işaretle.
+				for _, node := range path[1 : len(path)-1] { //baştaki ve sondaki eleman hariç elemanlar üstünde dolaş
+					usedNodes[node] = true //daha önce kullanıldı olarak değişiklik yapar
+				}
+
+				// Bir sonraki yol için geriye izleme (backtracking) yap.
+				backtrack(i + 1)
+				/*Bu işlem, bir yolun tamamlanmasından sonra diğer olası
+				yolları aramak için tekrarlanır, böylece tüm olası yollar taranır ve en uzun, üst üste binmeyen yollar bulunur.*/
+				//yeni bir yol arayışını başlatır
+				// Backtrack: Son eklenen yolu ve düğümleri geri al.
+				currentPaths = currentPaths[:len(currentPaths)-1] //Bu adımlar, geri izleme işlemi sırasında, bir sonraki olası yolu aramak için bir önceki adıma geri dönülmesini sağlar.
+				for _, node := range path[1 : len(path)-1] {      //geri dönerek ihtimallerini buluyor
+					delete(usedNodes, node)
+				}
+			}
+		}
+	}
+
+	// Geriye izleme algoritmasını başlat.
+	backtrack(0)
+	return maxPaths
+}
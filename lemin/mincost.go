@@ -0,0 +1,122 @@
+package lemin
+
+import "container/heap"
+
+// infinity, ulaşılamayan düğümlerin uzaklığıdır.
+const infinity = int(^uint(0) >> 1)
+
+// augmentMinCost, artık ağda Bellman-Ford (SPFA) ile en düşük maliyetli artırıcı yolu bulur ve
+// akışı bir birim artırır. Ters kenarların maliyeti eksi olduğu için artırıcı yol, daha önce
+// seçilmiş yolları yeniden düzenleyebilir; k. artırımdan sonra toplam uzunluğu en küçük k yol elde edilir.
+func (n *flowNetwork) augmentMinCost(source int, sink int) bool {
+	dist := make([]int, len(n.adj))
+	prevNode := make([]int, len(n.adj))
+	prevEdge := make([]int, len(n.adj))
+	inQueue := make([]bool, len(n.adj))
+	for i := range dist {
+		dist[i] = infinity
+		prevNode[i] = -1
+	}
+	dist[source] = 0
+
+	queue := []int{source}
+	inQueue[source] = true
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		inQueue[current] = false
+		for i, edge := range n.adj[current] {
+			if edge.Cap > 0 && dist[current]+edge.Cost < dist[edge.To] {
+				dist[edge.To] = dist[current] + edge.Cost
+				prevNode[edge.To] = current
+				prevEdge[edge.To] = i
+				if !inQueue[edge.To] {
+					queue = append(queue, edge.To)
+					inQueue[edge.To] = true
+				}
+			}
+		}
+	}
+
+	if dist[sink] == infinity {
+		return false
+	}
+	n.push(source, sink, prevNode, prevEdge)
+	return true
+}
+
+// newSuurballeAugment, Suurballe algoritmasının artırma adımını döndürür: her adımda düğüm
+// potansiyelleriyle eksi olmayan hale getirilmiş maliyetler üzerinde Dijkstra çalıştırılır.
+// Potansiyeller adımlar arasında saklandığı için fonksiyon tek bir ağ için kullanılmalıdır.
+func newSuurballeAugment() func(n *flowNetwork, source int, sink int) bool {
+	var potential []int
+	return func(n *flowNetwork, source int, sink int) bool {
+		if potential == nil {
+			potential = make([]int, len(n.adj)) // Başlangıçta bütün maliyetler eksi olmadığı için 0
+		}
+
+		dist := make([]int, len(n.adj))
+		prevNode := make([]int, len(n.adj))
+		prevEdge := make([]int, len(n.adj))
+		for i := range dist {
+			dist[i] = infinity
+			prevNode[i] = -1
+		}
+		dist[source] = 0
+
+		queue := &distQueue{{node: source, dist: 0}}
+		for queue.Len() > 0 {
+			item := heap.Pop(queue).(distItem)
+			if item.dist > dist[item.node] {
+				continue
+			}
+			for i, edge := range n.adj[item.node] {
+				if edge.Cap <= 0 {
+					continue
+				}
+				// İndirgenmiş maliyet: c(u,v) + p(u) - p(v) >= 0
+				reduced := edge.Cost + potential[item.node] - potential[edge.To]
+				if item.dist+reduced < dist[edge.To] {
+					dist[edge.To] = item.dist + reduced
+					prevNode[edge.To] = item.node
+					prevEdge[edge.To] = i
+					heap.Push(queue, distItem{node: edge.To, dist: dist[edge.To]})
+				}
+			}
+		}
+
+		if dist[sink] == infinity {
+			return false
+		}
+
+		// Potansiyelleri güncelle; bitişten uzak düğümler bitişin uzaklığıyla sınırlandırılır.
+		for v := range potential {
+			if dist[v] < dist[sink] {
+				potential[v] += dist[v]
+			} else {
+				potential[v] += dist[sink]
+			}
+		}
+		n.push(source, sink, prevNode, prevEdge)
+		return true
+	}
+}
+
+// distItem ve distQueue, Dijkstra için uzaklığa göre sıralı bir öncelik kuyruğudur.
+type distItem struct {
+	node int
+	dist int
+}
+
+type distQueue []distItem
+
+func (q distQueue) Len() int            { return len(q) }
+func (q distQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q distQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *distQueue) Push(x interface{}) { *q = append(*q, x.(distItem)) }
+func (q *distQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,210 @@
+package lemin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Move, bir karıncanın bir turda bir odaya geçişini temsil eder.
+type Move struct {
+	Ant  int // Karınca numarası (1'den başlar)
+	Room int // Karıncanın geçtiği odanın ID'si
+}
+
+// CheckMoves'un bulabileceği kural dışı hamleler. Dönen hatalar errors.Is ile bunlarla karşılaştırılabilir.
+var (
+	ErrUnknownAnt      = errors.New("böyle bir karınca yok")
+	ErrAntMovedTwice   = errors.New("karınca aynı turda iki kez hareket etti")
+	ErrAntAlreadyAtEnd = errors.New("karınca bitişe ulaştıktan sonra hareket etti")
+	ErrNoLink          = errors.New("odalar arasında bağlantı yok")
+	ErrRoomOccupied    = errors.New("odada kapasitesinden fazla karınca var")
+	ErrAntsNotAtEnd    = errors.New("bütün karıncalar bitişe ulaşmadı")
+	ErrTunnelBusy      = errors.New("bağlantıya aynı turda kapasitesinden fazla karınca girdi")
+	ErrAntInTransit    = errors.New("karınca bağlantıyı geçmeden odaya vardı")
+)
+
+// Rules, simülasyonda ve doğrulamada uygulanacak isteğe bağlı kurallardır.
+type Rules struct {
+	// OneAntPerTunnel, her bağlantıdan bir turda en fazla bir karıncanın geçebileceği standart
+	// kuralı uygular. Kapalıyken yalnızca odaların doluluğu denetlenir; örneğin başlangıçtan
+	// bitişe doğrudan bağlantı varsa o bağlantıdaki bütün karıncalar aynı turda geçebilir.
+	OneAntPerTunnel bool
+}
+
+// tunnelKey, iki oda arasındaki bağlantıyı yönden bağımsız olarak tanımlar.
+func tunnelKey(a int, b int) [2]int {
+	if a > b {
+		return [2]int{b, a}
+	}
+	return [2]int{a, b}
+}
+
+// tunnelLimit, a ile b arasındaki bağlantıya bir turda girebilecek en fazla karınca sayısını
+// döndürür: bağlantının kapasitesi belirtilmişse odur, değilse OneAntPerTunnel kuralı açıkken 1;
+// ikisi de yoksa bağlantıda sınır yoktur.
+func (g *Graph) tunnelLimit(a int, b int, rules Rules) int {
+	if edge := g.Link(a, b); edge != nil && edge.Capacity > 0 {
+		return edge.Capacity
+	}
+	if rules.OneAntPerTunnel {
+		return 1
+	}
+	return infinity
+}
+
+// MoveError, kural dışı hamleyi ve hamlenin yapıldığı turu taşır.
+type MoveError struct {
+	Turn int    // Hamlenin yapıldığı tur (1'den başlar); çözümün bütünüyle ilgili hatalarda 0
+	Move string // Hamlenin "Lx-oda" biçimindeki metni
+	Err  error  // Hatanın türü (ErrNoLink, ErrRoomOccupied, ...)
+}
+
+func (e *MoveError) Error() string {
+	if e.Turn == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%d. tur %q: %v", e.Turn, e.Move, e.Err)
+}
+
+func (e *MoveError) Unwrap() error {
+	return e.Err
+}
+
+// FormatMove, hamleyi "Lx-oda" biçiminde yazar.
+func (g *Graph) FormatMove(move Move) string {
+	return fmt.Sprintf("L%d-%s", move.Ant, g.Nodes[move.Room].Name)
+}
+
+// CheckMoves, antCount karıncanın tur tur verilen hamlelerini baştan oynatır ve ilk kural dışı
+// hamleyi döndürür. Bir karınca her turda en fazla bir kez ve yalnızca bağlantılı bir odaya
+// geçebilir; başlangıç ve bitiş dışındaki odalarda tur sonunda kapasitesinden fazla karınca
+// bulunamaz ve son turdan sonra bütün karıncalar bitişte olmalıdır. Hamle, karıncanın odaya
+// vardığı turda yazılır. Birden uzun süren bir bağlantıdan geçen karınca, en geç bağlantının süresi
+// kadar önce yola çıkmış olmalıdır; daha önce çıktıysa bağlantının ucunda beklemiştir. Karıncanın
+// ne zaman yola çıktığı hamlelerde yazmadığı için bağlantı kapasitesinin izin verdiği en erken tur
+// sayılır (Simulator da karıncaları bağlantılara ilk fırsatta sokar).
+func (g *Graph) CheckMoves(antCount int, turns [][]Move) error {
+	return g.CheckMovesWithRules(antCount, turns, Rules{})
+}
+
+// hop, bir karıncanın bir bağlantıdan geçişidir.
+type hop struct {
+	from, to int
+	ready    int    // Bağlantıya girebileceği en erken tur
+	deadline int    // Bağlantıya girmiş olması gereken en geç tur
+	arrival  int    // Odaya vardığı tur
+	text     string // Hamlenin "Lx-oda" biçimindeki metni
+}
+
+// CheckMovesWithRules, CheckMoves gibi çalışır ve ayrıca rules ile açılan kuralları denetler.
+func (g *Graph) CheckMovesWithRules(antCount int, turns [][]Move, rules Rules) error {
+	// Bütün karıncalar başlangıç odasından yola çıkar.
+	positions := make([]int, antCount+1)
+	for ant := 1; ant <= antCount; ant++ {
+		positions[ant] = g.StartNodeID
+	}
+	arrivals := make([]int, antCount+1) // Her karıncanın bulunduğu odaya vardığı tur
+
+	// İlk geçiş, her hamleyi tek başına denetler ve geçişleri toplar; ilk hatada durur.
+	var hops []hop
+	var failure error
+	failTurn := len(turns) + 1
+	for t, moves := range turns {
+		moved := make(map[int]bool)
+		for _, move := range moves {
+			fail := func(err error) error {
+				text := fmt.Sprintf("L%d-?", move.Ant)
+				if move.Room >= 0 && move.Room < len(g.Nodes) {
+					text = g.FormatMove(move)
+				}
+				return &MoveError{Turn: t + 1, Move: text, Err: err}
+			}
+
+			switch {
+			case move.Ant < 1 || move.Ant > antCount:
+				failure = fail(ErrUnknownAnt)
+			case moved[move.Ant]:
+				failure = fail(ErrAntMovedTwice)
+			case positions[move.Ant] == g.EndNodeID:
+				failure = fail(ErrAntAlreadyAtEnd)
+			case !contains(g.AdjList[positions[move.Ant]], move.Room):
+				failure = fail(ErrNoLink)
+			case t+1 < arrivals[move.Ant]+g.LinkLength(positions[move.Ant], move.Room):
+				failure = fail(ErrAntInTransit)
+			}
+			if failure != nil {
+				failTurn = t + 1
+				break
+			}
+			// Bir turluk bağlantılarda karınca bağlantıda bekleyemez; hamle turunda yola çıkmıştır.
+			length := g.LinkLength(positions[move.Ant], move.Room)
+			h := hop{from: positions[move.Ant], to: move.Room, ready: arrivals[move.Ant] + 1, deadline: t + 2 - length, arrival: t + 1, text: g.FormatMove(move)}
+			if length == 1 {
+				h.ready = h.deadline
+			}
+			moved[move.Ant] = true
+			hops = append(hops, h)
+			positions[move.Ant] = move.Room
+			arrivals[move.Ant] = t + 1
+		}
+		if failure != nil {
+			break
+		}
+	}
+
+	// İkinci geçiş, geçişleri tur tur oynatarak bağlantı ve oda kapasitelerini denetler. Yola
+	// çıkabilecek geçişler, en geç çıkması gereken önce olmak üzere bağlantılara sokulur. Hatalı
+	// hamlenin turunda odalar denetlenmez; o turun hamleleri tamamlanmamıştır.
+	readied := make(map[int][]int)  // Her turda yola çıkabilir hale gelen geçişler
+	arriving := make(map[int][]int) // Her turda odalara varan geçişler
+	for i, h := range hops {
+		readied[h.ready] = append(readied[h.ready], i)
+		arriving[h.arrival] = append(arriving[h.arrival], i)
+	}
+	// occupants, her odadaki karınca sayısını tutar; waiting, henüz yola çıkmamış geçişlerdir.
+	occupants := map[int]int{g.StartNodeID: antCount}
+	var waiting []int
+	for turn := 1; turn <= len(turns) && turn <= failTurn; turn++ {
+		waiting = append(waiting, readied[turn]...)
+		sort.SliceStable(waiting, func(a, b int) bool { return hops[waiting[a]].deadline < hops[waiting[b]].deadline })
+		tunnels := make(map[[2]int]int) // Bu turda bağlantılara giren karınca sayısı
+		var late []int
+		for _, i := range waiting {
+			h := hops[i]
+			if tunnels[tunnelKey(h.from, h.to)] >= g.tunnelLimit(h.from, h.to, rules) {
+				if h.deadline <= turn {
+					return &MoveError{Turn: h.arrival, Move: h.text, Err: ErrTunnelBusy}
+				}
+				late = append(late, i)
+				continue
+			}
+			tunnels[tunnelKey(h.from, h.to)]++
+			occupants[h.from]--
+		}
+		waiting = late
+		for _, i := range arriving[turn] {
+			occupants[hops[i].to]++
+		}
+
+		// Tur sonunda hiçbir odada kapasitesinden fazla karınca olmamalıdır.
+		if turn == failTurn {
+			break
+		}
+		for _, i := range arriving[turn] {
+			if occupants[hops[i].to] > g.RoomCapacity(hops[i].to) {
+				return &MoveError{Turn: turn, Move: hops[i].text, Err: ErrRoomOccupied}
+			}
+		}
+	}
+	if failure != nil {
+		return failure
+	}
+
+	for ant := 1; ant <= antCount; ant++ {
+		if positions[ant] != g.EndNodeID {
+			return &MoveError{Err: ErrAntsNotAtEnd}
+		}
+	}
+	return nil
+}
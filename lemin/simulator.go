@@ -0,0 +1,144 @@
+package lemin
+
+import "sort"
+
+// Simulator, karıncaları atandıkları yollarda tur tur ilerleten simülasyon motorudur. Yazdırma
+// yapmaz; her Step çağrısı bir turun hamlelerini döndürür. Karıncalar her turda numara sırasıyla
+// ele alındığı için aynı girdi her zaman aynı hamleleri üretir.
+type Simulator struct {
+	graph     *Graph
+	paths     [][]int     // Her karıncanın izlediği yol
+	steps     []int       // Her karıncanın yolundaki mevcut (veya yolda olduğu) odanın indeksi
+	arrivals  []int       // Her karıncanın o odaya vardığı tur (yoldaysa bağlantının ucuna varacağı tur)
+	occupants map[int]int // Her odadaki karınca sayısı (bağlantıların içindekiler hariç)
+	transit   []bool      // Karınca bir bağlantının içinde yolda mı
+	inTransit int         // Bağlantıların içinde yolda olan karınca sayısı
+	finished  int         // Bitişe ulaşan karınca sayısı
+	turn      int         // Tamamlanan tur sayısı
+	rules     Rules       // Uygulanan isteğe bağlı kurallar
+}
+
+// NewSimulator, karınca i+1'in paths[antPaths[i]] yolunu izlediği ve rules kurallarının
+// uygulandığı bir simülasyon oluşturur. Bütün karıncalar başlangıç odasında başlar.
+func NewSimulator(g *Graph, paths [][]int, antPaths []int, rules Rules) *Simulator {
+	s := &Simulator{
+		graph:     g,
+		paths:     make([][]int, len(antPaths)),
+		steps:     make([]int, len(antPaths)),
+		arrivals:  make([]int, len(antPaths)),
+		transit:   make([]bool, len(antPaths)),
+		occupants: map[int]int{g.StartNodeID: len(antPaths)},
+		rules:     rules,
+	}
+	for i, p := range antPaths {
+		s.paths[i] = paths[p]
+	}
+	return s
+}
+
+// Done, bütün karıncalar bitişe ulaştıysa true döndürür.
+func (s *Simulator) Done() bool {
+	return s.finished == len(s.paths)
+}
+
+// Turn, şimdiye kadar tamamlanan tur sayısını döndürür.
+func (s *Simulator) Turn() int {
+	return s.turn
+}
+
+// Position, karıncanın (1'den başlayan numarasıyla) bulunduğu odanın ID'sini döndürür; karınca
+// bir bağlantının içinde yoldaysa varacağı odayı döndürür.
+func (s *Simulator) Position(ant int) int {
+	return s.paths[ant-1][s.steps[ant-1]]
+}
+
+// Step, bir tur ilerler ve bu turda yapılan hamleleri döndürür. Her karınca yolundaki bir sonraki
+// odada yer varsa (oda kapasitesinden az karınca varsa veya bitiş odasıysa) oraya geçer, yoksa
+// bekler. Bağlantının kapasitesi belirtilmişse (veya OneAntPerTunnel kuralı açıksa) bu turda
+// bağlantıya o kadar karınca girmiş olan bağlantıyı kullanacak karınca da bekler. Bekleyen
+// karıncalar, aynı turda boşalan odalara geçebilmeleri için hiçbir karınca kıpırdamayana kadar
+// yeniden denenir.
+//
+// Birden uzun süren bir bağlantı, karıncaları içinde tutabilir: bağlantıya giren karınca, varacağı
+// odanın doluluğuna bakılmadan yola çıkar ve çıktığı odayı hemen boşaltır. Bağlantının süresi
+// dolduğunda odada yer varsa odaya geçer, yoksa yer açılana kadar bağlantının ucunda bekler;
+// hamlesi odaya geçtiği turda yazılır. Hamleler karınca numarasına göre sıralıdır. Yalnızca
+// bağlantılara giren karıncalar varsa tur hamlesiz geçer; hiçbir karınca hareket edemiyorsa tur
+// sayısı artmaz.
+func (s *Simulator) Step() []Move {
+	moves := []Move{}
+	if s.Done() {
+		return moves
+	}
+	turn := s.turn + 1
+	tunnels := make(map[[2]int]int) // Bu turda bağlantılara giren karınca sayısı
+	moved := false                  // Bu turda bir karınca bir bağlantıya girdi veya bir odaya geçti mi
+
+	for progress := true; progress; {
+		progress = false
+		for i, path := range s.paths {
+			if s.transit[i] {
+				// Bağlantının ucuna varan karınca, odada yer varsa odaya geçer.
+				room := path[s.steps[i]]
+				if s.arrivals[i] > turn || s.occupants[room] >= s.graph.RoomCapacity(room) {
+					continue
+				}
+				s.transit[i] = false
+				s.inTransit--
+				s.enter(i, room, turn)
+				moves = append(moves, Move{Ant: i + 1, Room: room})
+				progress = true
+				continue
+			}
+
+			current := path[s.steps[i]]
+			// Eğer karınca hedefe ulaştıysa veya bu tur zaten hareket ettiyse, sıradakine geçilir.
+			if current == s.graph.EndNodeID || s.arrivals[i] >= turn {
+				continue
+			}
+			next := path[s.steps[i]+1]
+			length := s.graph.LinkLength(current, next)
+			if length == 1 && s.occupants[next] >= s.graph.RoomCapacity(next) {
+				continue // Bir sonraki oda dolu; karınca bekler.
+			}
+			if tunnels[tunnelKey(current, next)] >= s.graph.tunnelLimit(current, next, s.rules) {
+				continue // Bağlantıya bu tur girebilecek kadar karınca girdi.
+			}
+			tunnels[tunnelKey(current, next)]++
+
+			// Karıncanın yeni konumu güncellenir; bir turluk bağlantılarda hareket hemen kaydedilir.
+			s.occupants[current]--
+			s.steps[i]++
+			progress = true
+			if length > 1 {
+				s.transit[i] = true
+				s.inTransit++
+				s.arrivals[i] = turn + length - 1
+				continue
+			}
+			s.enter(i, next, turn)
+			moves = append(moves, Move{Ant: i + 1, Room: next})
+		}
+		moved = moved || progress
+	}
+
+	// Bağlantıların içinde yolu henüz bitmemiş karıncalar varsa tur hamlesiz de olsa geçer.
+	travelling := false
+	for i := range s.paths {
+		travelling = travelling || s.transit[i] && s.arrivals[i] > turn
+	}
+	if moved || travelling {
+		sort.Slice(moves, func(a, b int) bool { return moves[a].Ant < moves[b].Ant })
+		s.turn++
+	}
+	return moves
+}
+
+// enter, i. karıncayı turn turunda room odasına yerleştirir.
+func (s *Simulator) enter(i int, room int, turn int) {
+	s.occupants[room]++
+	s.arrivals[i] = turn
+	if room == s.graph.EndNodeID {
+		s.finished++
+	}
+}
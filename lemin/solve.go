@@ -0,0 +1,148 @@
+package lemin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Solve'un döndürebileceği hatalar.
+var (
+	ErrInvalidAntCount = errors.New("geçersiz karınca sayısı")
+	ErrNoPath          = errors.New("başlangıçtan bitişe yol yok")
+	ErrTimeout         = errors.New("çözücü zaman aşımına uğradı")
+	ErrStalled         = errors.New("simülasyon bütün karıncalar bitişe ulaşmadan takıldı")
+)
+
+// Options, Solve'un davranışını ayarlar.
+type Options struct {
+	Solver  string        // Yolları seçecek çözücünün adı (boşsa DefaultSolver)
+	Verify  bool          // Üretilen hamleler CheckMoves ile doğrulansın mı
+	Timeout time.Duration // Çözücünün yol seçmek için harcayabileceği en fazla süre (0 ise sınırsız)
+	Rules   Rules         // Simülasyonda ve doğrulamada uygulanacak isteğe bağlı kurallar
+}
+
+// Solution, bir haritanın çözümünü tutar.
+type Solution struct {
+	Paths     [][]int  // Seçilen ayrık yollar (oda ID'leri, kısadan uzuna sıralı)
+	AntCounts []int    // Her yola atanan karınca sayısı
+	AntPaths  []int    // Her karıncanın (karınca i+1) izlediği yolun Paths içindeki indeksi
+	Turns     [][]Move // Tur tur hamleler
+	Explored  int      // Çözücünün yolları seçerken incelediği yol sayısı
+}
+
+// Solve, ants karıncayı g'nin başlangıç odasından bitiş odasına en az turda taşıyan ayrık yolları
+// seçer, karıncaları yollara dağıtır ve hamleleri tur tur üretir.
+func Solve(g *Graph, ants int, opts Options) (*Solution, error) {
+	if ants <= 0 {
+		return nil, ErrInvalidAntCount
+	}
+	if g.StartNodeID == -1 || g.EndNodeID == -1 {
+		return nil, ErrNoPath
+	}
+	name := opts.Solver
+	if name == "" {
+		name = DefaultSolver
+	}
+	solver, ok := SolverByName(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSolver, name)
+	}
+
+	// Ortak ara düğümü olmayan yolları seçilen çözücüyle bul.
+	paths, explored, err := findPaths(solver, g, ants, opts.Rules, opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 || len(paths[0]) == 0 {
+		return nil, ErrNoPath
+	}
+
+	// Yolları, sürelerine göre sıralar.
+	sort.Slice(paths, func(i, j int) bool {
+		return g.PathLength(paths[i]) < g.PathLength(paths[j])
+	})
+
+	// Karıncaları, bütün yollar aynı turda bitecek şekilde yollara dağıt.
+	counts, _ := g.DistributeAnts(paths, ants, opts.Rules)
+	solution := &Solution{
+		Paths:     paths,
+		AntCounts: counts,
+		AntPaths:  assignPathsToAnts(ants, counts),
+		Explored:  explored,
+	}
+
+	// Karıncaları tur tur ilerlet. Hiçbir karınca ilerleyemiyorsa simülasyon takılmıştır; eksik
+	// hamleler döndürülmez.
+	simulator := NewSimulator(g, solution.Paths, solution.AntPaths, opts.Rules)
+	solution.Turns = [][]Move{}
+	for !simulator.Done() {
+		turn := simulator.Step()
+		if simulator.Turn() == len(solution.Turns) {
+			break
+		}
+		solution.Turns = append(solution.Turns, turn)
+	}
+	if !simulator.Done() {
+		return nil, ErrStalled
+	}
+
+	// Simülasyonun ürettiği hamleler kurallara uygun mu kontrol edilir.
+	if opts.Verify {
+		if err := g.CheckMovesWithRules(ants, solution.Turns, opts.Rules); err != nil {
+			return nil, err
+		}
+	}
+	return solution, nil
+}
+
+// findPaths, çözücüyü çalıştırır. timeout sıfırdan büyükse çözücü ayrı bir goroutine'de çalışır ve
+// süre dolduğunda ErrTimeout döner; çözücü durdurulur ve goroutine bitene kadar beklenir, böylece
+// arka planda çalışmaya devam eden bir arama kalmaz.
+func findPaths(solver PathSolver, g *Graph, ants int, rules Rules, timeout time.Duration) ([][]int, int, error) {
+	if timeout <= 0 {
+		paths, explored := solver.Paths(g, ants, rules, nil)
+		return paths, explored, nil
+	}
+
+	type result struct {
+		paths    [][]int
+		explored int
+	}
+	results := make(chan result, 1)
+	done := make(chan struct{})
+	go func() {
+		paths, explored := solver.Paths(g, ants, rules, done)
+		results <- result{paths, explored}
+	}()
+
+	select {
+	case r := <-results:
+		return r.paths, r.explored, nil
+	case <-time.After(timeout):
+		close(done)
+		<-results
+		return nil, 0, ErrTimeout
+	}
+}
+
+// Karıncalara yol atamak için kullanılan fonksiyon. Karıncalar, DistributeAnts'in belirlediği
+// sayılara göre yollara sırayla dağıtılır; böylece her turda her yoldan bir karınca yola çıkar.
+// Her karınca için yolun indeksini döndürür.
+func assignPathsToAnts(antCount int, counts []int) []int {
+	antPaths := make([]int, 0, antCount)    // Karıncaların yollarını depolamak için bir slice oluşturulur.
+	remaining := append([]int{}, counts...) // Her yolda atanmayı bekleyen karınca sayısı
+
+	// Tüm karıncalar atanana kadar yollar üzerinde sırayla dolaş.
+	for len(antPaths) < antCount {
+		for i := range counts {
+			if remaining[i] > 0 && len(antPaths) < antCount {
+				antPaths = append(antPaths, i) // Bu yolun sıradaki karıncası atanır.
+				remaining[i]--
+			}
+		}
+	}
+
+	return antPaths // Tüm karıncaların atandığı yolların bulunduğu slice döndürülür.
+}
@@ -0,0 +1,244 @@
+package lemin_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"main.go/lemin"
+	"main.go/parser"
+)
+
+// parseMap, testlerdeki haritayı okur.
+func parseMap(t *testing.T, text string) (*lemin.Graph, int) {
+	t.Helper()
+	graph, ants, err := parser.Parse(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("harita okunamadı: %v", err)
+	}
+	return graph, ants
+}
+
+// Uzun bağlantıdan gelen karıncalar, varacakları oda doluysa bağlantının ucunda beklemelidir; yoksa
+// daha önce varıp bekleyen karıncalarla birlikte oda kapasitesi aşılır.
+func TestSolveRoomCapacityWithLongLinks(t *testing.T) {
+	graph, ants := parseMap(t, `6
+##start
+r0 0 7 3
+r1 1 48 2
+##end
+r2 2 43
+r0-r1::2
+r1-r2:1:3
+`)
+	for _, name := range lemin.SolverNames() {
+		for _, rules := range []lemin.Rules{{}, {OneAntPerTunnel: true}} {
+			solution, err := lemin.Solve(graph, ants, lemin.Options{Solver: name, Verify: true, Rules: rules})
+			if err != nil {
+				t.Errorf("%s %+v: %v", name, rules, err)
+				continue
+			}
+			if err := graph.CheckMovesWithRules(ants, solution.Turns, rules); err != nil {
+				t.Errorf("%s %+v: üretilen hamleler geçersiz: %v", name, rules, err)
+			}
+		}
+	}
+}
+
+// Uzun bir bağlantı birden fazla karıncayı içinde taşır; karıncalar odadan odaya boru hattı gibi
+// akar ve tur sayısı DistributeAnts'in hesabıyla aynı olur.
+func TestSolveLongLinkTurns(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		counts []int
+		turns  int
+	}{
+		{"tek yol", `4
+##start
+s 0 0
+a 1 0
+##end
+e 2 0
+s-a::3
+a-e
+`, []int{4}, 7},
+		{"iki yol", `10
+##start
+s 0 0
+a 1 0
+b1 0 1
+b2 1 1
+b3 2 1
+b4 3 1
+b5 4 1
+##end
+e 5 0
+s-a::3
+a-e
+s-b1
+b1-b2
+b2-b3
+b3-b4
+b4-b5
+b5-e
+`, []int{6, 4}, 9},
+	}
+	for _, test := range tests {
+		graph, ants := parseMap(t, test.text)
+		for _, rules := range []lemin.Rules{{}, {OneAntPerTunnel: true}} {
+			solution, err := lemin.Solve(graph, ants, lemin.Options{Verify: true, Rules: rules})
+			if err != nil {
+				t.Fatalf("%s %+v: %v", test.name, rules, err)
+			}
+			if len(solution.Turns) != test.turns || !reflect.DeepEqual(solution.AntCounts, test.counts) {
+				t.Errorf("%s %+v: %d tur, dağıtım %v; beklenen %d tur, dağıtım %v", test.name, rules, len(solution.Turns), solution.AntCounts, test.turns, test.counts)
+			}
+		}
+	}
+}
+
+// OneAntPerTunnel açıkken bir odanın kapasitesi, odaya giden bağlantının bir turda
+// taşıyabileceğinden fazla yol açmaz; odanın kapasitesini artırmak sonucu kötüleştirmemelidir.
+func TestSolveRoomCapacityRules(t *testing.T) {
+	const text = `9
+##start
+s 0 0
+a 1 1 %d
+b1 1 0
+b2 2 0
+b3 3 0
+##end
+e 4 1
+s-a
+a-e
+s-b1
+b1-b2
+b2-b3
+b3-e
+`
+	tests := []struct {
+		capacity int
+		rules    lemin.Rules
+		counts   []int
+		turns    int
+	}{
+		{1, lemin.Rules{OneAntPerTunnel: true}, []int{6, 3}, 7},
+		{3, lemin.Rules{OneAntPerTunnel: true}, []int{6, 3}, 7},
+		{3, lemin.Rules{}, []int{3, 3, 3}, 4},
+	}
+	for _, test := range tests {
+		graph, ants := parseMap(t, fmt.Sprintf(text, test.capacity))
+		for _, name := range []string{"edmonds-karp", "suurballe", "min-cost-flow"} {
+			solution, err := lemin.Solve(graph, ants, lemin.Options{Solver: name, Verify: true, Rules: test.rules})
+			if err != nil {
+				t.Fatalf("%s kapasite %d %+v: %v", name, test.capacity, test.rules, err)
+			}
+			if len(solution.Turns) != test.turns || !reflect.DeepEqual(solution.AntCounts, test.counts) {
+				t.Errorf("%s kapasite %d %+v: %d tur, dağıtım %v; beklenen %d tur, dağıtım %v", name, test.capacity, test.rules, len(solution.Turns), solution.AntCounts, test.turns, test.counts)
+			}
+		}
+	}
+}
+
+func TestCheckMoves(t *testing.T) {
+	graph, ants := parseMap(t, `2
+##start
+s 0 0
+a 1 0
+##end
+e 2 0
+s-a
+a-e::2
+`)
+	a := lemin.FindNodeIDByName(graph.Nodes, "a")
+	e := lemin.FindNodeIDByName(graph.Nodes, "e")
+
+	tests := []struct {
+		name  string
+		turns [][]lemin.Move
+		want  error
+	}{
+		{"geçerli", [][]lemin.Move{{{Ant: 1, Room: a}}, {{Ant: 2, Room: a}}, {{Ant: 1, Room: e}}, {{Ant: 2, Room: e}}}, nil},
+		{"oda dolu", [][]lemin.Move{{{Ant: 1, Room: a}, {Ant: 2, Room: a}}}, lemin.ErrRoomOccupied},
+		{"bağlantı geçilmeden varış", [][]lemin.Move{{{Ant: 1, Room: a}}, {{Ant: 1, Room: e}}}, lemin.ErrAntInTransit},
+		{"bağlantısız odalar", [][]lemin.Move{{{Ant: 1, Room: e}}}, lemin.ErrNoLink},
+		{"eksik karıncalar", [][]lemin.Move{{{Ant: 1, Room: a}}}, lemin.ErrAntsNotAtEnd},
+	}
+	for _, test := range tests {
+		err := graph.CheckMoves(ants, test.turns)
+		if test.want == nil && err != nil || test.want != nil && !errors.Is(err, test.want) {
+			t.Errorf("%s: hata %v, beklenen %v", test.name, err, test.want)
+		}
+	}
+}
+
+// Uzun bağlantıya erken giren karınca, oda boşalana kadar bağlantının ucunda bekleyebilir.
+func TestCheckMovesWaitInLink(t *testing.T) {
+	graph, ants := parseMap(t, `4
+##start
+s 0 0
+a 1 0 2
+##end
+e 2 0
+s-a::3
+a-e
+`)
+	a := lemin.FindNodeIDByName(graph.Nodes, "a")
+	e := lemin.FindNodeIDByName(graph.Nodes, "e")
+	pairs := [][]lemin.Move{{}, {}, {{Ant: 1, Room: a}, {Ant: 2, Room: a}}, {{Ant: 1, Room: e}, {Ant: 2, Room: e}, {Ant: 3, Room: a}, {Ant: 4, Room: a}}, {{Ant: 3, Room: e}, {Ant: 4, Room: e}}}
+
+	tests := []struct {
+		name  string
+		rules lemin.Rules
+		turns [][]lemin.Move
+		want  error
+	}{
+		{"boru hattı", lemin.Rules{OneAntPerTunnel: true}, [][]lemin.Move{{}, {}, {{Ant: 1, Room: a}}, {{Ant: 1, Room: e}, {Ant: 2, Room: a}}, {{Ant: 2, Room: e}, {Ant: 3, Room: a}}, {{Ant: 3, Room: e}, {Ant: 4, Room: a}}, {{Ant: 4, Room: e}}}, nil},
+		{"ikişer karınca", lemin.Rules{}, pairs, nil},
+		{"bağlantı dolu", lemin.Rules{OneAntPerTunnel: true}, pairs, lemin.ErrTunnelBusy},
+		{"oda dolu", lemin.Rules{}, [][]lemin.Move{{}, {}, {{Ant: 1, Room: a}, {Ant: 2, Room: a}, {Ant: 3, Room: a}}}, lemin.ErrRoomOccupied},
+	}
+	for _, test := range tests {
+		err := graph.CheckMovesWithRules(ants, test.turns, test.rules)
+		if test.want == nil && err != nil || test.want != nil && !errors.Is(err, test.want) {
+			t.Errorf("%s: hata %v, beklenen %v", test.name, err, test.want)
+		}
+	}
+}
+
+// Kural kapalıyken başlangıçtan bitişe kapasitesiz doğrudan bağlantı bütün karıncaları bir turda
+// geçirir; alt sınır ve dağıtım bunu hesaba katmalıdır.
+func TestDirectLinkRules(t *testing.T) {
+	graph, ants := parseMap(t, `20
+##start
+0 2 0
+1 4 1
+2 6 0
+##end
+3 5 3
+0-1
+0-3
+1-2
+3-2
+`)
+	tests := []struct {
+		rules lemin.Rules
+		turns int
+	}{
+		{lemin.Rules{}, 1},
+		{lemin.Rules{OneAntPerTunnel: true}, 11},
+	}
+	for _, test := range tests {
+		solution, err := lemin.Solve(graph, ants, lemin.Options{Verify: true, Rules: test.rules})
+		if err != nil {
+			t.Fatalf("%+v: %v", test.rules, err)
+		}
+		lowerBound := graph.LowerBound(ants, test.rules)
+		if len(solution.Turns) != test.turns || lowerBound > len(solution.Turns) {
+			t.Errorf("%+v: %d tur (alt sınır %d), beklenen %d tur", test.rules, len(solution.Turns), lowerBound, test.turns)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package lemin
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrUnknownSolver, Options.Solver kayıtlı olmayan bir çözücü adı olduğunda döner.
+var ErrUnknownSolver = errors.New("bilinmeyen çözücü")
+
+// DefaultSolver, Options.Solver boş bırakıldığında kullanılan çözücünün adıdır.
+const DefaultSolver = "edmonds-karp"
+
+// PathSolver, başlangıç odasından bitiş odasına giden ve ortak ara odası olmayan yolları seçen
+// bir algoritmadır. Paths, rules kurallarıyla ants karınca için kullanılacak yolları (yol yoksa
+// boş) ve seçim sırasında incelenen yol sayısını döndürür. done kapatıldığında Paths aramayı kısa
+// sürede bırakmalıdır; o durumda döndürdüğü sonuç kullanılmaz. nil done hiçbir zaman kapanmaz.
+type PathSolver interface {
+	Name() string
+	Paths(g *Graph, ants int, rules Rules, done <-chan struct{}) ([][]int, int)
+}
+
+// solvers, ada göre kayıtlı çözücülerdir.
+var solvers = make(map[string]PathSolver)
+
+// RegisterSolver, bir çözücüyü adıyla kaydeder; aynı adla kayıtlı çözücünün yerine geçer.
+func RegisterSolver(solver PathSolver) {
+	solvers[solver.Name()] = solver
+}
+
+// SolverByName, adı verilen çözücüyü döndürür.
+func SolverByName(name string) (PathSolver, bool) {
+	solver, ok := solvers[name]
+	return solver, ok
+}
+
+// SolverNames, kayıtlı çözücülerin adlarını alfabetik sırayla döndürür.
+func SolverNames() []string {
+	names := make([]string, 0, len(solvers))
+	for name := range solvers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	RegisterSolver(exhaustiveSolver{})
+	RegisterSolver(edmondsKarpSolver{})
+	RegisterSolver(suurballeSolver{})
+	RegisterSolver(minCostFlowSolver{})
+}
+
+// exhaustiveSolver, bütün basit yolları BFS ile listeler ve FilterPaths ile çakışmayan en fazla
+// sayıda yolu geriye izlemeyle seçer. Sonuç karınca sayısından bağımsızdır ve süre üsteldir;
+// yalnızca küçük haritalarda karşılaştırma için kullanılmalıdır.
+type exhaustiveSolver struct{}
+
+func (exhaustiveSolver) Name() string { return "bfs" }
+
+func (exhaustiveSolver) Paths(g *Graph, ants int, rules Rules, done <-chan struct{}) ([][]int, int) {
+	allPaths := g.bfsAllPaths(g.StartNodeID, g.EndNodeID, done)
+
+	// Tüm yolları, uzunluklarına göre sıralar.
+	sort.Slice(allPaths, func(i, j int) bool {
+		return len(allPaths[i]) < len(allPaths[j])
+	})
+	return filterPaths(allPaths, done), len(allPaths)
+}
+
+// edmondsKarpSolver, BFS ile bulunan en kısa artırıcı yollarla maksimum akışı hesaplar ve her
+// artırımdan sonra en az turu veren yol kümesini seçer.
+type edmondsKarpSolver struct{}
+
+func (edmondsKarpSolver) Name() string { return "edmonds-karp" }
+
+func (edmondsKarpSolver) Paths(g *Graph, ants int, rules Rules, done <-chan struct{}) ([][]int, int) {
+	return g.bestPaths(g.StartNodeID, g.EndNodeID, ants, rules, (*flowNetwork).augment, done)
+}
+
+// suurballeSolver, potansiyellerle Dijkstra kullanan Suurballe algoritmasıyla her k için toplam
+// uzunluğu en küçük k ayrık yolu bulur ve en az turu veren kümeyi seçer.
+type suurballeSolver struct{}
+
+func (suurballeSolver) Name() string { return "suurballe" }
+
+func (suurballeSolver) Paths(g *Graph, ants int, rules Rules, done <-chan struct{}) ([][]int, int) {
+	return g.bestPaths(g.StartNodeID, g.EndNodeID, ants, rules, newSuurballeAugment(), done)
+}
+
+// minCostFlowSolver, Bellman-Ford ile en ucuz artırıcı yolları bulan minimum maliyetli akışla
+// her k için toplam uzunluğu en küçük k ayrık yolu bulur ve en az turu veren kümeyi seçer.
+type minCostFlowSolver struct{}
+
+func (minCostFlowSolver) Name() string { return "min-cost-flow" }
+
+func (minCostFlowSolver) Paths(g *Graph, ants int, rules Rules, done <-chan struct{}) ([][]int, int) {
+	return g.bestPaths(g.StartNodeID, g.EndNodeID, ants, rules, (*flowNetwork).augmentMinCost, done)
+}
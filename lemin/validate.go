@@ -0,0 +1,138 @@
+package lemin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Validate'in bulabileceği kural ihlalleri. Dönen hatalar errors.Is ile bunlarla karşılaştırılabilir.
+var (
+	ErrDuplicateRoom        = errors.New("aynı isimde oda zaten tanımlı")
+	ErrDuplicateCoordinates = errors.New("aynı koordinatlarda oda zaten tanımlı")
+	ErrInvalidRoomName      = errors.New("oda ismi 'L' veya '#' ile başlayamaz")
+	ErrSelfLink             = errors.New("oda kendisine bağlanamaz")
+	ErrDuplicateLink        = errors.New("bağlantı zaten tanımlı")
+	ErrMultipleStart        = errors.New("birden fazla ##start komutu")
+	ErrMultipleEnd          = errors.New("birden fazla ##end komutu")
+	ErrRoomAfterLink        = errors.New("oda bağlantılardan sonra tanımlanmış")
+	ErrUnparsedLine         = errors.New("satır oda veya bağlantı olarak yorumlanamadı")
+)
+
+// ValidationError, bir kural ihlalini ve ihlalin bulunduğu satırı taşır.
+type ValidationError struct {
+	Line int    // İhlalin bulunduğu satır (bilinmiyorsa 0)
+	Text string // İhlalin bulunduğu satırın metni veya ilgili oda/bağlantı
+	Err  error  // İhlalin türü (ErrDuplicateRoom, ErrSelfLink, ...)
+}
+
+func (e *ValidationError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%q: %v", e.Text, e.Err)
+	}
+	return fmt.Sprintf("%d. satır %q: %v", e.Line, e.Text, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors, Validate'in bulduğu tüm ihlalleri bir arada taşır.
+type ValidationErrors []error
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Is, ihlallerden herhangi biri target ile eşleşiyorsa true döndürür.
+func (e ValidationErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate, grafı lem-in harita kurallarına göre denetler ve bulduğu tüm ihlalleri
+// satır sırasıyla döndürür. İhlal yoksa nil döndürür.
+func (g *Graph) Validate() ValidationErrors {
+	var violations ValidationErrors
+	report := func(line int, text string, err error) {
+		violations = append(violations, &ValidationError{Line: line, Text: text, Err: err})
+	}
+
+	// Birden fazla ##start veya ##end komutu
+	for i, line := range g.StartLines {
+		if i > 0 {
+			report(line, "##start", ErrMultipleStart)
+		}
+	}
+	for i, line := range g.EndLines {
+		if i > 0 {
+			report(line, "##end", ErrMultipleEnd)
+		}
+	}
+
+	// İlk bağlantının satırı; bundan sonra tanımlanan odalar kurala aykırıdır.
+	firstLinkLine := 0
+	for _, edge := range g.Edges {
+		if edge.Line != 0 && (firstLinkLine == 0 || edge.Line < firstLinkLine) {
+			firstLinkLine = edge.Line
+		}
+	}
+
+	// Oda kuralları: isim, tekrar eden isim ve koordinatlar, bağlantılardan sonra tanımlanma
+	names := make(map[string]bool)
+	coordinates := make(map[[2]int]bool)
+	for _, node := range g.Nodes {
+		text := fmt.Sprintf("%s %d %d", node.Name, node.X, node.Y)
+		if strings.HasPrefix(node.Name, "L") || strings.HasPrefix(node.Name, "#") {
+			report(node.Line, text, ErrInvalidRoomName)
+		}
+		if names[node.Name] {
+			report(node.Line, text, ErrDuplicateRoom)
+		}
+		if coordinates[[2]int{node.X, node.Y}] {
+			report(node.Line, text, ErrDuplicateCoordinates)
+		}
+		if firstLinkLine != 0 && node.Line > firstLinkLine {
+			report(node.Line, text, ErrRoomAfterLink)
+		}
+		names[node.Name] = true
+		coordinates[[2]int{node.X, node.Y}] = true
+	}
+
+	// Bağlantı kuralları: kendine bağlantı ve tekrar eden bağlantı
+	links := make(map[[2]int]bool)
+	for _, edge := range g.Edges {
+		text := g.Nodes[edge.Start].Name + "-" + g.Nodes[edge.End].Name
+		if edge.Start == edge.End {
+			report(edge.Line, text, ErrSelfLink)
+			continue
+		}
+		key := [2]int{edge.Start, edge.End}
+		if edge.Start > edge.End {
+			key = [2]int{edge.End, edge.Start}
+		}
+		if links[key] {
+			report(edge.Line, text, ErrDuplicateLink)
+		}
+		links[key] = true
+	}
+
+	// Yorumlanamayan satırlar
+	for _, line := range g.Unparsed {
+		report(line.Line, line.Text, ErrUnparsedLine)
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].(*ValidationError).Line < violations[j].(*ValidationError).Line
+	})
+	return violations
+}
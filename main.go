@@ -1,466 +1,272 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
-	"sort"
-	"strconv"
 	"strings"
 	"time"
-)
-
-type Node struct {
-	ID   int    // Node ID
-	Name string // Node Name
-	X    int    // X coordinate
-	Y    int    // Y coordinate
-}
 
-type Edge struct {
-	Start int // Starting node ID of the edge
-	End   int // Ending node ID of the edge
-}
-
-type Graph struct {
-	Nodes       []Node        // List of nodes in the graph
-	Edges       []Edge        // List of edges in the graph
-	StartNodeID int           // ID of the start node
-	EndNodeID   int           // ID of the end node
-	AdjList     map[int][]int // Adjacency list representing the graph
-}
+	"main.go/generator"
+	"main.go/i18n"
+	"main.go/lemin"
+	"main.go/parser"
+	"main.go/render"
+	"main.go/visual"
+)
 
 // Function to print all nodes
-func printNodes(nodes []Node) {
-	fmt.Println("\nthe_rooms:")
+func printNodes(nodes []lemin.Node) {
+	fmt.Println("\n" + i18n.T("Rooms:"))
 	for _, node := range nodes {
 		fmt.Printf("%d: %s (%d, %d)\n", node.ID, node.Name, node.X, node.Y)
 	}
 }
 
 // Function to print all edges
-func printEdges(edges []Edge) {
-	fmt.Println("\nthe_links:")
+func printEdges(edges []lemin.Edge) {
+	fmt.Println("\n" + i18n.T("Links:"))
 	for _, edge := range edges {
 		fmt.Printf("%d - %d\n", edge.Start, edge.End)
 	}
 }
 
-// startNodeID'den endNodeID'ye kadar olan tüm yolları BFS kullanarak bulmak için bir fonksiyon
-func (g *Graph) BFSAllPaths(startNodeID int, endNodeID int) [][]int {
-	// Bulunan yolları saklamak için bir slice
-	paths := [][]int{}
-	// BFS kuyruğu, başlangıçta sadece başlangıç düğümünü içerir
-	queue := [][]int{{startNodeID}}
-
-	// Kuyruk boşalana kadar devam et
-	for len(queue) > 0 {
-		// Kuyruğun ilk yolunu al ve kuyruktan çıkar
-		path := queue[0]
-		queue = queue[1:]
-		// Yolun son düğümünü al
-		node := path[len(path)-1]
-
-		// Eğer son düğüm bitiş düğümü ise, bu yolu sonuçlara ekle
-		if node == endNodeID {
-			paths = append(paths, path)
-			continue
-		}
-
-		// Son düğümün komşularını kontrol et
-		for _, neighbor := range g.AdjList[node] {
-			// Eğer komşu zaten bu yolun içinde değilse, yeni bir yol oluştur
-			if !contains(path, neighbor) {
-				// Mevcut yolu kopyala
-				newPath := append([]int{}, path...)
-				// Yeni yolu komşu ile genişlet
-				newPath = append(newPath, neighbor)
-				// Yeni yolu kuyruğa ekle
-				queue = append(queue, newPath)
-			}
-		}
+// Function to echo the input map verbatim, followed by an empty line
+// Haritayı olduğu gibi yazdırır; dosya satır sonu ile bitmiyorsa önce satırı tamamlar.
+func printInput(input []byte) {
+	os.Stdout.Write(input)
+	if len(input) > 0 && input[len(input)-1] != '\n' {
+		fmt.Println()
 	}
-
-	// Bulunan tüm yolları geri döndür
-	return paths
+	fmt.Println()
 }
 
-func contains(slice []int, item int) bool {
-	for _, v := range slice {
-		if v == item {
-			return true
-		}
+// readInput, haritayı verilen dosyadan okur. Dosya adı boşsa veya "-" ise harita
+// standart girişten okunur; böylece harita başka bir programdan aktarılabilir.
+func readInput(filename string) ([]byte, error) {
+	if filename == "" || filename == "-" {
+		return io.ReadAll(os.Stdin)
 	}
-	return false
+	return os.ReadFile(filename)
 }
 
-// Function to find the node ID by its name
-// Bir düğümün adını kullanarak düğüm ID'sini bulmak için bir fonksiyon
-func findNodeIDByName(nodes []Node, name string) int {
-	// nodes slice'ındaki her bir node üzerinde döngü başlat
-	for _, node := range nodes {
-		// Eğer node'un adı verilen name'e eşitse
-		if node.Name == name {
-			// node.ID'yi döndür
-			return node.ID
-		}
-	}
-	// Eğer verilen name'e sahip bir node bulunamazsa, -1 döndür
-	return -1
+// langFlag, mesaj dilini seçen -lang bayrağını tanımlar. Dil, bayraklar ayrıştırılmadan önce
+// i18n.Detect ile belirlendiği için bayrağın değeri burada kullanılmaz.
+func langFlag(flags *flag.FlagSet) {
+	flags.String("lang", i18n.Language(), i18n.T("message language: en or tr (default from LANG)"))
 }
 
-// Function to find an alternative path for an ant if the primary path is blocked
-// Başlangıç düğümünden bitiş düğümüne giden birincil yol engellenmişse karınca için alternatif bir yol bulan bir fonksiyon.
-func findAlternativePath(graph Graph, currentPos int, occupied map[int]bool) []int {
-	// Tüm yolları hesaplamak için BFS kullanarak başlangıç konumundan bitiş düğümüne giden tüm yolları bul.
-	allPaths := graph.BFSAllPaths(currentPos, graph.EndNodeID)
-
-	// Tüm bulunan yollar üzerinde döngü başlat.
-	for _, path := range allPaths {
-		// Yolun geçerli olup olmadığını kontrol etmek için bir bayrak oluştur.
-		valid := true
-
-		// Yoldaki her düğüm için kontrol yap.
-		for _, node := range path {
-			// Eğer düğüm işgal edilmişse,
-			if occupied[node] {
-				// Yolu geçersiz olarak işaretle ve döngüyü sonlandır.
-				valid = false
-				break
+// parseFlags, bayrakları args'tan okur. flags, flag.ContinueOnError ile oluşturulmalıdır: hatalı
+// veya bilinmeyen bayraklar Go'nun kendi mesajı yerine failUsage ile "ERROR: ..." olarak raporlanır;
+// -h/-help istendiğinde seçilen dildeki kullanım metni yazdırılır ve program başarıyla biter.
+func parseFlags(flags *flag.FlagSet, args []string) {
+	flags.Usage = func() { printUsage(flags) }
+	var usage bytes.Buffer
+	output := flags.Output()
+	flags.SetOutput(&usage)
+	err := flags.Parse(args)
+	flags.SetOutput(output)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		io.Copy(output, &usage)
+		os.Exit(0)
+	case err != nil:
+		message := err.Error()
+		for _, prefix := range flagErrors {
+			if rest := strings.TrimPrefix(message, prefix); rest != message {
+				message = i18n.T(prefix) + rest
 			}
 		}
-
-		// Eğer yol geçerliyse,
-		if valid {
-			// Bu yolun alternatif bir yol olduğunu belirtmek için yol dizisini döndür.
-			return path
-		}
+		failUsage("%s", message)
 	}
-
-	// Eğer engelsiz bir alternatif yol bulunamazsa, nil döndür.
-	return nil
 }
 
-// FilterPaths, verilen yollar arasından düğüm çakışmalarını önleyerek en fazla sayıda yolu seçer.
-func FilterPaths(paths [][]int) [][]int {
-	// maxPaths, en fazla sayıda geçerli yolu saklar.
-	var maxPaths [][]int
-	// currentPaths, geçerli durumda incelenen yolları saklar.
-	var currentPaths [][]int
-	// usedNodes, kullanılan düğümleri izlemek için bir harita.
-	usedNodes := make(map[int]bool)
-
-	// backtrack, geriye izleme algoritması için iç içe bir fonksiyon olarak tanımlanır.
-	var backtrack func(int)
-	backtrack = func(start int) {
-		// Eğer geçerli yolların sayısı, maksimum yollardan fazlaysa, maxPaths güncellenir.
-		if len(currentPaths) > len(maxPaths) {
-			maxPaths = make([][]int, len(currentPaths)) //maxPaths değişkeni, currentPaths ile aynı sayıda elemana sahip boş bir slice'e dönüşüyor
-			//maxpaths değişkeni adında currentpaths uzunluğunda int değerinde değişken oluşturuyor
-			copy(maxPaths, currentPaths) //içerisine kopyalıyor
+// printUsage, flag paketinin varsayılan kullanım metnini seçilen dilde yazar: başlık ve her bayrak
+// için türü, açıklaması ve sıfır değilse varsayılan değeri.
+func printUsage(flags *flag.FlagSet) {
+	output := flags.Output()
+	fmt.Fprintln(output, i18n.T("Usage of %s:", flags.Name()))
+	flags.VisitAll(func(f *flag.Flag) {
+		line := "  -" + f.Name
+		name, usage := flag.UnquoteUsage(f)
+		if name != "" {
+			line += " " + name
 		}
-
-		// Başlangıç indeksinden yolların sonuna kadar dolaş.
-		for i := start; i < len(paths); i++ {
-			path := paths[i] //yolları tek tek path değişkenine atıyor
-			keepPath := true //kullanılma durumunu kontrol ediyor
-
-			// İlk ve son düğüm hariç, yolun düğümlerini kontrol et.
-			for _, node := range path[1 : len(path)-1] { //İlk ve son değişkenleri hariç bütün değişkenler alsın
-				// Eğer düğüm daha önce kullanıldıysa, bu yolu kullanma.
-				if usedNodes[node] {
-					keepPath = false
-					break
-				}
+		// Tek harfli ve değer almayan bayrakların açıklaması aynı satıra yazılır.
+		if len(line) <= 4 {
+			line += "\t"
+		} else {
+			line += "\n    \t"
+		}
+		line += strings.ReplaceAll(usage, "\n", "\n    \t")
+		switch f.Value.(flag.Getter).Get().(type) {
+		case string:
+			if f.DefValue != "" {
+				line += i18n.T(" (default %s)", fmt.Sprintf("%q", f.DefValue))
 			}
-
-			// Eğer yol geçerliyse (düğümler kullanılmamışsa), yollar listesine ekle.
-			if keepPath {
-				currentPaths = append(currentPaths, path)
-				// Kullanılan düğümleri işaretle.
-				for _, node := range path[1 : len(path)-1] { //baştaki ve sondaki eleman hariç elemanlar üstünde dolaş
-					usedNodes[node] = true //daha önce kullanıldı olarak değişiklik yapar
-				}
-
-				// Bir sonraki yol için geriye izleme (backtracking) yap.
-				backtrack(i + 1)
-				/*Bu işlem, bir yolun tamamlanmasından sonra diğer olası
-				yolları aramak için tekrarlanır, böylece tüm olası yollar taranır ve en uzun, üst üste binmeyen yollar bulunur.*/
-				//yeni bir yol arayışını başlatır
-				// Backtrack: Son eklenen yolu ve düğümleri geri al.
-				currentPaths = currentPaths[:len(currentPaths)-1] //Bu adımlar, geri izleme işlemi sırasında, bir sonraki olası yolu aramak için bir önceki adıma geri dönülmesini sağlar.
-				for _, node := range path[1 : len(path)-1] {      //geri dönerek ihtimallerini buluyor
-					delete(usedNodes, node)
-				}
+		default:
+			if f.DefValue != "0" && f.DefValue != "false" && f.DefValue != "0s" {
+				line += i18n.T(" (default %s)", f.DefValue)
 			}
 		}
-	}
+		fmt.Fprintln(output, line)
+	})
+}
+
+// flagErrors, flag paketinin hata mesajlarının seçilen dile çevrilen başlarıdır; bayrak adı
+// gibi geri kalan kısım aynen korunur.
+var flagErrors = []string{
+	"flag provided but not defined: ",
+	"flag needs an argument: ",
+	"bad flag syntax: ",
+}
 
-	// Geriye izleme algoritmasını başlat.
-	backtrack(0)
-	return maxPaths
+// keyboard, adım adım görsel modda tuşların okunacağı kaynağı döndürür. Harita standart girişten
+// okunduysa standart giriş tükenmiştir; bu durumda terminal doğrudan /dev/tty üzerinden açılır.
+func keyboard(filename string) io.Reader {
+	if filename != "" && filename != "-" {
+		return os.Stdin
+	}
+	if tty, err := os.Open("/dev/tty"); err == nil {
+		return tty
+	}
+	return os.Stdin
 }
 
 func main() {
 	startTime := time.Now() // Başlangıç zamanını al
 
-	if len(os.Args) != 2 {
-		fmt.Println("Dosya adı belirtilmedi.")
-		return
+	// Dil, yardım metinleri de doğru dilde yazılsın diye bayraklar tanımlanmadan önce belirlenir.
+	i18n.SetLanguage(i18n.Detect(os.Args[1:]))
+
+	// Alt komutlar: "check" bir çözümü haritaya göre doğrular, "generate" test haritası üretir,
+	// "bench" çözücüleri bir harita klasörü üzerinde karşılaştırır, "render" haritayı ve yolları
+	// SVG/PNG olarak çizer.
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "check":
+			runCheck(os.Args[2:])
+			return
+		case "generate":
+			runGenerate(os.Args[2:])
+			return
+		case "bench":
+			runBench(os.Args[2:])
+			return
+		case "render":
+			runRender(os.Args[2:])
+			return
+		}
 	}
 
-	file, err := os.Open(os.Args[1])
-	if err != nil {
-		fmt.Println("Dosya açma hatası:", err)
-		return
+	// --verbose verilirse eski ayrıntılı rapor, verilmezse standart lem-in çıktısı yazdırılır.
+	flag.CommandLine.Init(os.Args[0], flag.ContinueOnError)
+	verbose := flag.Bool("verbose", false, i18n.T("detailed report (rooms, links, step numbers and elapsed time)"))
+	solverName := flag.String("solver", lemin.DefaultSolver, i18n.T("path solver: ")+strings.Join(lemin.SolverNames(), ", "))
+	oneAntPerTunnel := flag.Bool("one-ant-per-tunnel", false, i18n.T("at most one ant may use each link per turn"))
+	visualMode := flag.Bool("visual", false, i18n.T("animate the map and the ants in the terminal"))
+	delay := flag.Duration("delay", 500*time.Millisecond, i18n.T("delay between turns in --visual mode"))
+	stepMode := flag.Bool("step", false, i18n.T("wait for Enter after every turn in --visual mode"))
+	htmlFile := flag.String("html", "", i18n.T("write an HTML page that replays the moves in a browser to this file"))
+	timeout := flag.Duration("timeout", 0, i18n.T("time limit for choosing the paths (0: no limit)"))
+	format := flag.String("format", "text", i18n.T("output format: text or json"))
+	langFlag(flag.CommandLine)
+	parseFlags(flag.CommandLine, os.Args[1:])
+
+	if *format != "text" && *format != "json" {
+		failUsage("unknown output format: %s", *format)
 	}
-	defer file.Close()
 
-	graph := Graph{
-		AdjList:     make(map[int][]int), // Düğümlerin komşuluk ilişkilerini depolamak için bir harita oluşturulur.
-		StartNodeID: -1,                  // Başlangıç düğümünün ID'si -1 olarak başlatılır (bu değer daha sonra belirlenecektir).
-		EndNodeID:   -1,                  // Bitiş düğümünün ID'si -1 olarak başlatılır (bu değer daha sonra belirlenecektir).
+	if flag.NArg() > 1 {
+		failUsage("more than one file name given")
 	}
 
-	scanner := bufio.NewScanner(file)
-
-	// Sayıda karıncayı oku
-	scanner.Scan()
-	antCountLine := scanner.Text()
-	antCount, err := strconv.Atoi(antCountLine)
+	// Harita, çıktıda aynen tekrar yazdırılabilmesi için tamamen belleğe okunur.
+	input, err := readInput(flag.Arg(0))
 	if err != nil {
-		fmt.Println("HATA: Geçersiz veri formatı") // Hata durumunda geçersiz veri formatı hatası yazdırılır
-		return
-	}
-	if antCount <= 0 {
-		fmt.Println("HATA: Geçersiz veri formatı") // Hata durumunda geçersiz veri formatı hatası yazdırılır
-		return
+		fail(err)
 	}
 
-	// Graf verilerini oku
-	for scanner.Scan() {
-		line := scanner.Text() // Bir sonraki satırı oku
-		if strings.HasPrefix(line, "##start") {
-			scanner.Scan()
-			fields := strings.Fields(scanner.Text())
-			if len(fields) < 3 {
-				fmt.Println("HATA: Geçersiz veri formatı")
-				return
-			}
-			name := fields[0]                 // Oda ismini al
-			x, err := strconv.Atoi(fields[1]) // X koordinatını al
-			if err != nil {
-				fmt.Println("HATA: Geçersiz veri formatı")
-				return
-			}
-			y, err := strconv.Atoi(fields[2]) // Y koordinatını al
-			if err != nil {
-				fmt.Println("HATA: Geçersiz veri formatı")
-				return
-			}
-			startID := len(graph.Nodes)                                                  // Başlangıç düğümünün ID'sini belirle
-			graph.StartNodeID = startID                                                  // Graf yapısındaki başlangıç düğüm ID'sini güncelle
-			graph.Nodes = append(graph.Nodes, Node{ID: startID, Name: name, X: x, Y: y}) // Başlangıç düğümünü graf düğümlerine ekle
-
-		} else if strings.HasPrefix(line, "##end") {
-			scanner.Scan()
-			fields := strings.Fields(scanner.Text())
-			if len(fields) < 3 {
-				fmt.Println("HATA: Geçersiz veri formatı")
-				return
-			}
-			name := fields[0]                 // Oda ismini al
-			x, err := strconv.Atoi(fields[1]) // X koordinatını al
-			if err != nil {
-				fmt.Println("HATA: Geçersiz veri formatı")
-				return
-			}
-			y, err := strconv.Atoi(fields[2]) // Y koordinatını al
-			if err != nil {
-				fmt.Println("HATA: Geçersiz veri formatı")
-				return
-			}
-			endID := len(graph.Nodes)                                                  // Bitiş düğümünün ID'sini belirle
-			graph.EndNodeID = endID                                                    // Graf yapısındaki bitiş düğüm ID'sini güncelle
-			graph.Nodes = append(graph.Nodes, Node{ID: endID, Name: name, X: x, Y: y}) // Bitiş düğümünü graf düğümlerine ekle
-
-		} else {
-			fields := strings.Fields(line) // Satırı alanlara ayır
-			if len(fields) == 3 {          // Eğer üç alana ayrılmışsa (ID, X, Y)
-				name := fields[0]                 // Oda ismini al
-				x, err := strconv.Atoi(fields[1]) // X koordinatını al
-				if err != nil {
-					fmt.Println("HATA: Geçersiz veri formatı")
-					return
-				}
-				y, err := strconv.Atoi(fields[2]) // Y koordinatını al
-				if err != nil {
-					fmt.Println("HATA: Geçersiz veri formatı")
-					return
-				}
-				id := len(graph.Nodes)                                                  // Düğümün ID'sini belirle
-				graph.Nodes = append(graph.Nodes, Node{ID: id, Name: name, X: x, Y: y}) // Düğümü graf düğümlerine ekle
-			} else if len(fields) == 1 && strings.Contains(line, "-") { // Eğer bir alan içeriyor ve içinde "-" karakteri varsa (bir kenar)
-				edgeParts := strings.Split(fields[0], "-") // Kenarı ayır
-				if len(edgeParts) != 2 {                   // Eğer iki kısım yoksa (başlangıç ve bitiş düğümleri eksikse)
-					fmt.Println("HATA: Geçersiz veri formatı")
-					return
-				}
-				startName := edgeParts[0]                           // Başlangıç düğüm ismini al
-				endName := edgeParts[1]                             // Bitiş düğüm ismini al
-				startID := findNodeIDByName(graph.Nodes, startName) // Başlangıç düğüm ID'sini bul
-				endID := findNodeIDByName(graph.Nodes, endName)     // Bitiş düğüm ID'sini bul
-				if startID == -1 || endID == -1 {                   // Eğer başlangıç veya bitiş düğümü bulunamadıysa
-					fmt.Println("HATA: Geçersiz veri formatı")
-					return
-				}
-				graph.Edges = append(graph.Edges, Edge{Start: startID, End: endID}) // Kenarı graf kenarlarına ekle
-				graph.AdjList[startID] = append(graph.AdjList[startID], endID)      // Başlangıç düğümünün komşuları listesine bitiş düğümünü ekle
-				graph.AdjList[endID] = append(graph.AdjList[endID], startID)        // Bitiş düğümünün komşuları listesine başlangıç düğümünü ekle
-			}
-		}
+	// Haritayı oku ve grafı oluştur.
+	graph, antCount, err := parser.ParseAny(input)
+	if err != nil {
+		fail(err)
 	}
 
-	if err := scanner.Err(); err != nil {
-		fmt.Println("HATA: Okuma hatası") // Okuma hatası
-		return
+	// Yolları seç, karıncaları dağıt ve hamleleri üret.
+	rules := lemin.Rules{OneAntPerTunnel: *oneAntPerTunnel}
+	solution, err := lemin.Solve(graph, antCount, lemin.Options{
+		Solver:  *solverName,
+		Verify:  true,
+		Timeout: *timeout,
+		Rules:   rules,
+	})
+	if err != nil {
+		fail(err)
 	}
 
-	if graph.StartNodeID == -1 || graph.EndNodeID == -1 {
-		fmt.Println("HATA: Başlangıç veya bitiş düğümü belirtilmedi") // Hata: Başlangıç veya bitiş düğümü belirtilmedi
-		return
+	// İstenirse hamleler, tarayıcıda oynatılabilecek bir HTML sayfasına da yazılır.
+	if *htmlFile != "" {
+		writeOutput(*htmlFile, func(w io.Writer) error { return render.HTML(w, graph, antCount, solution.Turns) })
 	}
 
-	allPaths := graph.BFSAllPaths(graph.StartNodeID, graph.EndNodeID)
-	if len(allPaths) == 0 {
-		fmt.Println("HATA: Geçersiz veri formatı")
+	// Görsel modda hamleler yazdırılmaz, terminalde canlandırılır.
+	if *visualMode {
+		options := visual.Options{Delay: *delay, Step: *stepMode, Keys: keyboard(flag.Arg(0))}
+		if err := visual.Animate(os.Stdout, graph, antCount, solution.Turns, options); err != nil {
+			fail(err)
+		}
 		return
 	}
 
-	// Tüm yolları, uzunluklarına göre sıralar.
-	sort.Slice(allPaths, func(i, j int) bool {
-		return len(allPaths[i]) < len(allPaths[j])
-	})
-
-	// Sıralanmış yollardan oluşan 'allPaths' slice'ını filtreler.
-	filteredPaths := FilterPaths(allPaths)
-
-	// Eğer filtrelenmiş yolların uzunluğu 0 ise veya ilk yolu boşsa,
-	// bu durumda geçersiz bir veri formatı olduğunu belirten bir hata mesajı yazdırılır.
-	if len(filteredPaths) == 0 || len(filteredPaths[0]) == 0 {
-		fmt.Println("HATA: Geçersiz veri formatı")
+	// JSON biçiminde harita, yollar ve hamleler tek bir belge olarak yazdırılır.
+	if *format == "json" {
+		if err := writeJSON(os.Stdout, graph, antCount, solution, *solverName, rules); err != nil {
+			fail(err)
+		}
 		return
 	}
 
 	// Giriş verilerini yazdır
-	fmt.Printf("Karınca sayısı: %d\n", antCount)
-	fmt.Printf("Başlangıç odası: %d\n", graph.StartNodeID)
-	fmt.Printf("Bitiş odası: %d\n", graph.EndNodeID)
-	printNodes(graph.Nodes)
-	printEdges(graph.Edges)
-
-	antPaths := assignPathsToAnts(antCount, filteredPaths)
-	antPositions := make([]int, antCount)
-	antAtEnd := make([]bool, antCount)
-
-	// Bütün karıncaların pozisyonlarını ve yollarını başlat
-	for i := 0; i < antCount; i++ {
-		if i == antCount-1 {
-			antPaths[i] = filteredPaths[0] // Son karınca en kısa yolu takip eder
-		} else {
-			antPaths[i] = filteredPaths[(i)%len(filteredPaths)] // Diğer karıncalar sırayla takip eder
-		}
-		antPositions[i] = graph.StartNodeID // Bütün karıncalar başlangıç pozisyonundadır
-		antAtEnd[i] = false                 // Hiçbir karınca bitişe ulaşmamıştır
+	if *verbose {
+		fmt.Println(i18n.T("Number of ants: %d", antCount))
+		fmt.Println(i18n.T("Start room: %d", graph.StartNodeID))
+		fmt.Println(i18n.T("End room: %d", graph.EndNodeID))
+		printNodes(graph.Nodes)
+		printEdges(graph.Edges)
+	} else if parser.IsDOT(input) {
+		// DOT haritaları, çıktının standart lem-in biçiminde kalması için çevrilerek yazdırılır.
+		generator.Write(os.Stdout, graph, antCount)
+		fmt.Println()
+	} else {
+		printInput(input)
 	}
 
-	step := 1 // Adım sayacı başlatılır.
-
-	// Sonsuz döngü başlatılır. Döngü, tüm karıncaların hedefe ulaşıncaya kadar devam eder.
-	for {
-		// Karıncaların yapacağı hareketlerin listesi başlatılır.
-		moves := []string{}
-
-		// Tüm karıncaların hedefe ulaşıp ulaşmadığı kontrol edilir.
-		allAtEnd := true
-
-		// Karıncaların mevcut konumları takip edilir.
-		occupied := make(map[int]bool)
-
-		// Bu adım için planlanmış hareketler toplanır.
-		for i := 0; i < antCount; i++ {
-			// Eğer karınca hedefe ulaşmadıysa, döngü devam eder.
-			if antPositions[i] != graph.EndNodeID {
-				allAtEnd = false // En az bir karıncanın hedefe ulaşmadığını belirtmek için bayrak ayarlanır.
-
-				// Karıncanın takip ettiği yol alınır.
-				path := antPaths[i]
-				for j := 0; j < len(path)-1; j++ {
-					// Karıncanın mevcut konumu ile hedefi arasında bir bağlantı var mı kontrol edilir.
-					if path[j] == antPositions[i] && (!occupied[path[j+1]] || path[j+1] == graph.EndNodeID) {
-						// Eğer bir bağlantı varsa, karıncanın yeni konumu güncellenir ve bu hareket kaydedilir.
-						occupied[path[j+1]] = true
-						antPositions[i] = path[j+1]
-						moves = append(moves, fmt.Sprintf("L%d-%s", i+1, graph.Nodes[antPositions[i]].Name))
-						break
-					}
-				}
-
-				// Eğer karıncanın yolu engellenmişse, alternatif yol aranır.
-				if antPositions[i] != graph.EndNodeID && occupied[antPositions[i]] {
-					altPath := findAlternativePath(graph, antPositions[i], occupied)
-					if altPath != nil {
-						antPaths[i] = altPath // Alternatif yol bulunursa, karıncanın yolu güncellenir.
-					}
-				}
-			} else {
-				antAtEnd[i] = true // Karınca hedefe ulaştıysa, bu bilgi kaydedilir.
-			}
-		}
-
-		// Bu adımda yapılacak hareketler ekrana yazdırılır.
-		if len(moves) > 0 {
-			fmt.Printf("Adım %d: %s\n", step, strings.Join(moves, " "))
-		}
-
-		// Eğer tüm karıncalar hedefe ulaştıysa, döngüden çıkılır.
-		if allAtEnd {
-			break
+	// Her tur, bir satırda boşlukla ayrılmış "Lx-oda" hamleleri olarak yazdırılır.
+	for step, turn := range solution.Turns {
+		moves := make([]string, len(turn))
+		for i, move := range turn {
+			moves[i] = graph.FormatMove(move)
 		}
-
-		step++ // Adım sayacı artırılır.
-
-		// Eğer tüm karıncaların son adımda hedefe ulaşamadığı tespit edilirse, döngüden çıkılır.
-		allAtEnd = true
-		for i := 0; i < antCount; i++ {
-			if !antAtEnd[i] {
-				allAtEnd = false
-				break
-			}
+		if *verbose {
+			fmt.Println(i18n.T("Step %d: %s", step+1, strings.Join(moves, " ")))
+		} else {
+			fmt.Println(strings.Join(moves, " "))
 		}
 	}
-	// elapsed time hesaplanır.
-	elapsedTime := time.Since(startTime)
 
-	// Toplam geçen süre saniye cinsinden hesaplanır ve kesirli kısmı ile birlikte ekrana yazdırılır.
-	fmt.Printf("Toplam süre: %.9f saniye\n", elapsedTime.Seconds())
-}
+	if *verbose {
+		// elapsed time hesaplanır.
+		elapsedTime := time.Since(startTime)
 
-// Karıncalara yol atamak için kullanılan fonksiyon. Yolların örtüşmemesini sağlar ve ilk karınca için en kısa yolu seçer.
-func assignPathsToAnts(antCount int, filteredPaths [][]int) [][]int {
-	antPaths := make([][]int, antCount) // Karıncaların yollarını depolamak için bir slice oluşturulur.
-	remainingPaths := filteredPaths     // Atanmamış yolları depolamak için bir değişken oluşturulur.
+		// Toplam geçen süre saniye cinsinden hesaplanır ve kesirli kısmı ile birlikte ekrana yazdırılır.
+		fmt.Println(i18n.T("Total time: %.9f seconds", elapsedTime.Seconds()))
 
-	// Tüm karıncalara yollar atanır
-	for i := 0; i < antCount; i++ {
-		if len(remainingPaths) > 0 {
-			antPaths[i] = remainingPaths[0]     // Atanmamış yollardan biri bu karıncaya atanır.
-			remainingPaths = remainingPaths[1:] // Atanmış olan yolu listeden kaldırılır.
-		} else {
-			// Eğer daha fazla benzersiz yol yoksa, mevcut yollar tekrar kullanılır.
-			antPaths[i] = filteredPaths[i%len(filteredPaths)]
-		}
+		// Üretilen tur sayısının teorik alt sınırdan ne kadar uzak olduğu yazdırılır.
+		lowerBound := graph.LowerBound(antCount, rules)
+		fmt.Println(i18n.T("Turns: %d, lower bound: %d, gap: %d", len(solution.Turns), lowerBound, len(solution.Turns)-lowerBound))
 	}
-
-	return antPaths // Tüm karıncaların atandığı yolların bulunduğu slice döndürülür.
 }
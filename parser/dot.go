@@ -0,0 +1,449 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"main.go/lemin"
+)
+
+// dotToken, DOT dosyasındaki bir sözcük, sayı, tırnaklı metin veya noktalama işaretidir.
+type dotToken struct {
+	text   string
+	quoted bool // Tırnak içinde yazılmış metinler anahtar sözcük veya işaret sayılmaz.
+	line   int
+}
+
+// dotNode, DOT dosyasında adı geçen bir odanın toplanan öznitelikleridir.
+type dotNode struct {
+	name  string
+	attrs map[string]string
+	line  int // Odanın ilk geçtiği satır
+}
+
+// dotEdge, iki oda arasındaki bir bağlantıdır.
+type dotEdge struct {
+	from, to string
+	attrs    map[string]string // Bağlantının öznitelikleri (capacity, length)
+	line     int
+}
+
+// dotReader, DOT sözdiziminin lem-in haritası için gereken alt kümesini okur: graph/digraph
+// gövdesi, oda ve bağlantı tanımları, öznitelik listeleri ve graf öznitelikleri. Alt graflar ve
+// HTML etiketleri desteklenmez.
+type dotReader struct {
+	tokens []dotToken
+	pos    int
+	lines  []string // Hata mesajları için kaynak satırlar
+	nodes  []*dotNode
+	byName map[string]*dotNode
+	edges  []dotEdge
+	attrs  map[string]string // Graf öznitelikleri (ants, ...)
+}
+
+// IsDOT, input'un bir DOT grafı olup olmadığını ilk anlamlı sözcüğüne bakarak tahmin eder.
+func IsDOT(input []byte) bool {
+	tokens, err := tokenizeDOT(string(input))
+	if err != nil || len(tokens) == 0 {
+		return false
+	}
+	switch strings.ToLower(tokens[0].text) {
+	case "graph", "digraph", "strict":
+		return !tokens[0].quoted
+	}
+	return false
+}
+
+// ParseAny, input DOT ise ParseDOT ile, değilse Parse ile okur.
+func ParseAny(input []byte) (*lemin.Graph, int, error) {
+	if IsDOT(input) {
+		return ParseDOT(bytes.NewReader(input))
+	}
+	return Parse(bytes.NewReader(input))
+}
+
+// ParseDOT, r'den GraphViz DOT biçiminde bir harita okur. Karınca sayısı "ants" graf özniteliğinden,
+// başlangıç ve bitiş odaları "start" ve "end" oda özniteliklerinden ("start=true"), oda kapasitesi
+// "capacity" özniteliğinden, bağlantı kapasitesi ve süresi "capacity" ve "length" bağlantı
+// özniteliklerinden, koordinatlar "pos" ("x,y" veya "x,y!") ya da "x" ve "y" özniteliklerinden
+// alınır. Koordinatı verilmeyen odalar, başka odalarla çakışmayacak şekilde sırayla yerleştirilir.
+// Yönlü bağlantılar yönsüz kabul edilir.
+func ParseDOT(r io.Reader) (*lemin.Graph, int, error) {
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	tokens, err := tokenizeDOT(string(input))
+	if err != nil {
+		return nil, 0, err
+	}
+	d := &dotReader{
+		tokens: tokens,
+		lines:  strings.Split(string(input), "\n"),
+		byName: make(map[string]*dotNode),
+		attrs:  make(map[string]string),
+	}
+	if err := d.parseGraph(); err != nil {
+		return nil, 0, err
+	}
+
+	antCount, err := strconv.Atoi(d.attrs["ants"])
+	if err != nil || antCount <= 0 {
+		return nil, 0, &ParseError{Err: ErrInvalidAntCount}
+	}
+
+	graph := lemin.NewGraph()
+	used := make(map[[2]int]bool)
+	var unplaced []*dotNode
+	for _, node := range d.nodes {
+		x, y, ok, err := node.position()
+		if err != nil {
+			return nil, 0, d.errorAt(node.line, err)
+		}
+		if ok {
+			used[[2]int{x, y}] = true
+		} else {
+			unplaced = append(unplaced, node)
+		}
+		id := graph.AddNode(node.name, x, y)
+		graph.Nodes[id].Line = node.line
+		if value, found := node.attrs["capacity"]; found {
+			capacity, err := strconv.Atoi(value)
+			if err != nil || capacity <= 0 {
+				return nil, 0, d.errorAt(node.line, ErrInvalidCapacity)
+			}
+			graph.Nodes[id].Capacity = capacity
+		}
+		if dotFlag(node.attrs, "start") {
+			graph.StartNodeID = id
+			graph.StartLines = append(graph.StartLines, node.line)
+		}
+		if dotFlag(node.attrs, "end") {
+			graph.EndNodeID = id
+			graph.EndLines = append(graph.EndLines, node.line)
+		}
+	}
+	// Koordinatsız odalar, boş bulunan ilk (i, 0) noktalarına yerleştirilir.
+	x := 0
+	for _, node := range unplaced {
+		for used[[2]int{x, 0}] {
+			x++
+		}
+		used[[2]int{x, 0}] = true
+		id := lemin.FindNodeIDByName(graph.Nodes, node.name)
+		graph.Nodes[id].X, graph.Nodes[id].Y = x, 0
+	}
+
+	for _, edge := range d.edges {
+		graph.AddEdge(lemin.FindNodeIDByName(graph.Nodes, edge.from), lemin.FindNodeIDByName(graph.Nodes, edge.to))
+		capacity, length, err := parseLinkProperties(edge.attrs["capacity"] + ":" + edge.attrs["length"])
+		if err != nil {
+			return nil, 0, d.errorAt(edge.line, err)
+		}
+		link := &graph.Edges[len(graph.Edges)-1]
+		link.Line = edge.line
+		link.Capacity = capacity
+		link.Length = length
+	}
+
+	// DOT'ta odalar bağlantılardan sonra da tanımlanabilir.
+	if err := checkGraph(graph, lemin.ErrRoomAfterLink); err != nil {
+		return nil, 0, err
+	}
+	return graph, antCount, nil
+}
+
+// parseGraph, "[strict] (graph|digraph) [ad] { ... }" yapısını okur.
+func (d *dotReader) parseGraph() error {
+	if d.keyword("strict") {
+		d.pos++
+	}
+	if !d.keyword("graph") && !d.keyword("digraph") {
+		return d.errorAtToken(ErrInvalidDOT)
+	}
+	d.pos++
+	if tok, ok := d.peek(); ok && (tok.quoted || !isDOTPunct(tok.text)) {
+		d.pos++ // Grafın adı
+	}
+	if !d.accept("{") {
+		return d.errorAtToken(ErrInvalidDOT)
+	}
+	for !d.accept("}") {
+		if _, ok := d.peek(); !ok {
+			return d.errorAtToken(ErrInvalidDOT)
+		}
+		if err := d.parseStatement(); err != nil {
+			return err
+		}
+		d.accept(";")
+	}
+	return nil
+}
+
+// parseStatement, bir oda, bağlantı veya öznitelik tanımını okur.
+func (d *dotReader) parseStatement() error {
+	tok, _ := d.peek()
+	if !tok.quoted {
+		switch strings.ToLower(tok.text) {
+		case "graph", "node", "edge":
+			// Varsayılan öznitelikler; yalnızca graf öznitelikleri (ants) kullanılır.
+			d.pos++
+			attrs, err := d.parseAttrs()
+			if err != nil {
+				return err
+			}
+			if strings.ToLower(tok.text) == "graph" {
+				for key, value := range attrs {
+					d.attrs[key] = value
+				}
+			}
+			return nil
+		case "subgraph":
+			return d.errorAtToken(ErrInvalidDOT)
+		}
+		if isDOTPunct(tok.text) {
+			return d.errorAtToken(ErrInvalidDOT)
+		}
+	}
+	d.pos++
+
+	// "ad = değer" bir graf özniteliğidir.
+	if d.accept("=") {
+		value, ok := d.id()
+		if !ok {
+			return d.errorAtToken(ErrInvalidDOT)
+		}
+		d.attrs[tok.text] = value
+		return nil
+	}
+
+	names := []string{tok.text}
+	for d.accept("--") || d.accept("->") {
+		name, ok := d.id()
+		if !ok {
+			return d.errorAtToken(ErrInvalidDOT)
+		}
+		names = append(names, name)
+	}
+	attrs, err := d.parseAttrs()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		node := d.node(name, tok.line)
+		if len(names) == 1 {
+			for key, value := range attrs {
+				node.attrs[key] = value
+			}
+		}
+	}
+	for i := 1; i < len(names); i++ {
+		d.edges = append(d.edges, dotEdge{from: names[i-1], to: names[i], attrs: attrs, line: tok.line})
+	}
+	return nil
+}
+
+// parseAttrs, varsa "[a=b, c=d]" öznitelik listelerini okur.
+func (d *dotReader) parseAttrs() (map[string]string, error) {
+	attrs := make(map[string]string)
+	for d.accept("[") {
+		for !d.accept("]") {
+			key, ok := d.id()
+			if !ok {
+				return nil, d.errorAtToken(ErrInvalidDOT)
+			}
+			value := "true"
+			if d.accept("=") {
+				if value, ok = d.id(); !ok {
+					return nil, d.errorAtToken(ErrInvalidDOT)
+				}
+			}
+			attrs[key] = value
+			if !d.accept(",") {
+				d.accept(";")
+			}
+		}
+	}
+	return attrs, nil
+}
+
+// node, adı verilen odayı döndürür; oda ilk kez geçiyorsa oluşturur.
+func (d *dotReader) node(name string, line int) *dotNode {
+	if node, ok := d.byName[name]; ok {
+		return node
+	}
+	node := &dotNode{name: name, attrs: make(map[string]string), line: line}
+	d.byName[name] = node
+	d.nodes = append(d.nodes, node)
+	return node
+}
+
+func (d *dotReader) peek() (dotToken, bool) {
+	if d.pos >= len(d.tokens) {
+		return dotToken{}, false
+	}
+	return d.tokens[d.pos], true
+}
+
+// accept, sıradaki işaret punct ise onu tüketir ve true döndürür.
+func (d *dotReader) accept(punct string) bool {
+	if tok, ok := d.peek(); ok && !tok.quoted && tok.text == punct {
+		d.pos++
+		return true
+	}
+	return false
+}
+
+// keyword, sıradaki sözcük (büyük/küçük harf fark etmeksizin) word ise true döndürür.
+func (d *dotReader) keyword(word string) bool {
+	tok, ok := d.peek()
+	return ok && !tok.quoted && strings.EqualFold(tok.text, word)
+}
+
+// id, sıradaki sözcüğü veya tırnaklı metni tüketip döndürür.
+func (d *dotReader) id() (string, bool) {
+	tok, ok := d.peek()
+	if !ok || (!tok.quoted && isDOTPunct(tok.text)) {
+		return "", false
+	}
+	d.pos++
+	return tok.text, true
+}
+
+// errorAtToken, sıradaki işaretin satırı için bir ParseError oluşturur.
+func (d *dotReader) errorAtToken(err error) *ParseError {
+	if tok, ok := d.peek(); ok {
+		return d.errorAt(tok.line, err)
+	}
+	return &ParseError{Err: err}
+}
+
+func (d *dotReader) errorAt(line int, err error) *ParseError {
+	text := ""
+	if line > 0 && line <= len(d.lines) {
+		text = strings.TrimRight(d.lines[line-1], "\r")
+	}
+	return &ParseError{Line: line, Text: text, Err: err}
+}
+
+// position, odanın "pos" veya "x"/"y" özniteliklerinden koordinatlarını okur; öznitelik yoksa ok false olur.
+func (n *dotNode) position() (x int, y int, ok bool, err error) {
+	xText, yText := n.attrs["x"], n.attrs["y"]
+	if pos, found := n.attrs["pos"]; found {
+		parts := strings.Split(strings.TrimSuffix(pos, "!"), ",")
+		if len(parts) != 2 {
+			return 0, 0, false, ErrInvalidRoom
+		}
+		xText, yText = parts[0], parts[1]
+	}
+	if xText == "" && yText == "" {
+		return 0, 0, false, nil
+	}
+	fx, errX := strconv.ParseFloat(strings.TrimSpace(xText), 64)
+	fy, errY := strconv.ParseFloat(strings.TrimSpace(yText), 64)
+	if errX != nil || errY != nil {
+		return 0, 0, false, ErrInvalidRoom
+	}
+	return int(math.Round(fx)), int(math.Round(fy)), true, nil
+}
+
+// dotFlag, attrs[key] verilmiş ve "false", "0" veya "no" değilse true döndürür.
+func dotFlag(attrs map[string]string, key string) bool {
+	value, ok := attrs[key]
+	if !ok {
+		return false
+	}
+	switch strings.ToLower(value) {
+	case "false", "0", "no":
+		return false
+	}
+	return true
+}
+
+func isDOTPunct(text string) bool {
+	switch text {
+	case "{", "}", "[", "]", "=", ";", ",", ":", "--", "->":
+		return true
+	}
+	return false
+}
+
+// tokenizeDOT, DOT metnini işaretlere ayırır; "//", "#" ve "/* */" yorumlarını atlar.
+func tokenizeDOT(input string) ([]dotToken, error) {
+	var tokens []dotToken
+	runes := []rune(input)
+	line := 1
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\n':
+			line++
+		case unicode.IsSpace(r):
+		case r == '#' || (r == '/' && i+1 < len(runes) && runes[i+1] == '/'):
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			start := line
+			i += 2
+			for i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/') {
+				if runes[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			if i+1 >= len(runes) {
+				return nil, &ParseError{Line: start, Err: ErrInvalidDOT}
+			}
+			i++
+		case r == '"':
+			start := line
+			var b strings.Builder
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && runes[i+1] == '"' {
+					i++
+				} else if runes[i] == '\\' && i+1 < len(runes) && runes[i+1] == '\n' {
+					i++
+					line++
+					continue
+				} else if runes[i] == '\n' {
+					line++
+				}
+				b.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, &ParseError{Line: start, Err: ErrInvalidDOT}
+			}
+			tokens = append(tokens, dotToken{text: b.String(), quoted: true, line: start})
+		case r == '-' && i+1 < len(runes) && (runes[i+1] == '-' || runes[i+1] == '>'):
+			tokens = append(tokens, dotToken{text: string(runes[i : i+2]), line: line})
+			i++
+		case strings.ContainsRune("{}[]=;,:", r):
+			tokens = append(tokens, dotToken{text: string(r), line: line})
+		case r == '<':
+			return nil, &ParseError{Line: line, Err: ErrInvalidDOT} // HTML etiketleri desteklenmez.
+		default:
+			start := i
+			for i+1 < len(runes) && isDOTIDRune(runes[i+1], runes, i+1) {
+				i++
+			}
+			tokens = append(tokens, dotToken{text: string(runes[start : i+1]), line: line})
+		}
+	}
+	return tokens, nil
+}
+
+// isDOTIDRune, runes[i]'nin tırnaksız bir sözcüğün devamı olup olmadığını söyler; "--" ve "->"
+// bağlantı işaretleri sözcüğü bitirir.
+func isDOTIDRune(r rune, runes []rune, i int) bool {
+	if r == '-' && i+1 < len(runes) && (runes[i+1] == '-' || runes[i+1] == '>') {
+		return false
+	}
+	return !unicode.IsSpace(r) && !strings.ContainsRune("{}[]=;,:\"#", r)
+}
@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+
+	"main.go/lemin"
+)
+
+// Haritada bulunabilecek hata türleri. Parse'ın döndürdüğü hatalar errors.Is ile bunlarla karşılaştırılabilir.
+// Kural ihlalleri (tekrar eden oda, kendine bağlantı, ...) lemin.Validate'ten gelir ve
+// lemin.ValidationErrors olarak hepsi birlikte döner.
+var (
+	ErrInvalidAntCount     = lemin.ErrInvalidAntCount
+	ErrInvalidRoom         = errors.New("geçersiz oda tanımı")
+	ErrInvalidCapacity     = errors.New("oda kapasitesi pozitif bir tam sayı olmalı")
+	ErrDuplicateRoom       = lemin.ErrDuplicateRoom
+	ErrInvalidLink         = errors.New("geçersiz bağlantı tanımı")
+	ErrInvalidLinkProperty = errors.New("bağlantı kapasitesi ve süresi pozitif tam sayı olmalı")
+	ErrUnknownRoomInLink   = errors.New("bağlantıda tanımsız oda")
+	ErrNoStart             = errors.New("başlangıç odası belirtilmedi")
+	ErrNoEnd               = errors.New("bitiş odası belirtilmedi")
+	ErrNoRoomAfterCommand  = errors.New("komuttan sonra oda tanımı yok")
+	ErrNoPath              = lemin.ErrNoPath
+	ErrInvalidDOT          = errors.New("geçersiz veya desteklenmeyen DOT sözdizimi")
+	ErrInvalidMove         = errors.New("geçersiz hamle, \"Lx-oda\" bekleniyordu")
+	ErrUnknownRoomInMove   = errors.New("hamlede tanımsız oda")
+)
+
+// ParseError, hatanın türünü, oluştuğu satırı ve o satırın metnini taşır.
+type ParseError struct {
+	Line int    // Hatalı satırın numarası (1'den başlar); dosyanın bütünüyle ilgili hatalarda 0
+	Text string // Hatalı satırın metni
+	Err  error  // Hatanın türü (ErrInvalidAntCount, ErrDuplicateRoom, ...)
+}
+
+func (e *ParseError) Error() string {
+	if e.Line == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%d. satır %q: %v", e.Line, e.Text, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"main.go/lemin"
+)
+
+// ParseMoves, r'den "Lx-oda" hamle satırlarını okur ve her satırı bir tur olarak döndürür.
+// Girdi programın tam çıktısıysa (harita, boş satır, hamleler) haritanın bulunduğu kısım, ilk hamle
+// satırına kadar atlanır; oda isimleri "L" ile başlayamadığı için haritada bu biçimde bir satır olmaz.
+// Haritada birden uzun süren bağlantılar varsa hamleler arasındaki (ve haritayı izleyen boş satırdan
+// sonraki) boş satırlar, bütün karıncaların bağlantılarda yolda olduğu hamlesiz turlardır; yoksa
+// boş satırlar atlanır.
+func ParseMoves(r io.Reader, graph *lemin.Graph) ([][]lemin.Move, error) {
+	scanner := &lineScanner{Scanner: bufio.NewScanner(r)}
+	turns := [][]lemin.Move{}
+	skipMap := false // Harita kısmı ilk hamle satırına kadar atlanıyor mu
+	mapLines := 0    // Atlanan haritanın boş olmayan satır sayısı
+	blanks := 0      // İlk hamleden hemen önceki boş satır sayısı
+	emptyTurns := hasLongLinks(graph)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if scanner.line == 1 && !strings.HasPrefix(line, "L") {
+			skipMap = true // İlk satır hamle değilse, harita yankısıdır.
+		}
+		if skipMap {
+			switch {
+			case line == "":
+				blanks++
+				continue
+			case !strings.HasPrefix(line, "L"):
+				mapLines++
+				blanks = 0
+				continue
+			}
+			skipMap = false
+			if mapLines > 0 && blanks > 0 {
+				blanks-- // Haritayı hamlelerden ayıran boş satır
+			}
+			for ; emptyTurns && blanks > 0; blanks-- {
+				turns = append(turns, []lemin.Move{})
+			}
+		}
+		if line == "" && !emptyTurns {
+			continue
+		}
+
+		moves := []lemin.Move{}
+		for _, field := range strings.Fields(line) {
+			move, err := parseMove(graph, field)
+			if err != nil {
+				return nil, scanner.errorAt(err)
+			}
+			moves = append(moves, move)
+		}
+		turns = append(turns, moves)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	// Sondaki boş satırlar tur sayılmaz.
+	for len(turns) > 0 && len(turns[len(turns)-1]) == 0 {
+		turns = turns[:len(turns)-1]
+	}
+	return turns, nil
+}
+
+// parseMove, "Lx-oda" biçimindeki tek bir hamleyi çözer.
+func parseMove(graph *lemin.Graph, field string) (lemin.Move, error) {
+	if !strings.HasPrefix(field, "L") {
+		return lemin.Move{}, ErrInvalidMove
+	}
+	parts := strings.SplitN(field[1:], "-", 2) // Karınca numarası ve oda ismini ayır
+	if len(parts) != 2 {
+		return lemin.Move{}, ErrInvalidMove
+	}
+	ant, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return lemin.Move{}, ErrInvalidMove
+	}
+	room := lemin.FindNodeIDByName(graph.Nodes, parts[1])
+	if room == -1 {
+		return lemin.Move{}, ErrUnknownRoomInMove
+	}
+	return lemin.Move{Ant: ant, Room: room}, nil
+}
+
+// hasLongLinks, grafta geçilmesi birden fazla tur süren bir bağlantı olup olmadığını döndürür;
+// yalnızca böyle bağlantılarda bütün karıncalar yolda kalıp hamlesiz bir tur geçebilir.
+func hasLongLinks(graph *lemin.Graph) bool {
+	for _, edge := range graph.Edges {
+		if edge.Length > 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,278 @@
+// Package parser, lem-in harita dosyasını okuyup lemin.Graph yapısına çevirir.
+package parser
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"main.go/lemin"
+)
+
+// lineScanner, okunan satırın numarasını da takip eden bir bufio.Scanner sarmalayıcısıdır.
+type lineScanner struct {
+	*bufio.Scanner
+	line int // Son okunan satırın numarası
+}
+
+func (s *lineScanner) Scan() bool {
+	s.line++
+	return s.Scanner.Scan()
+}
+
+// scanContent, boş satırları ve yorumları atlayarak sıradaki anlamlı satıra ilerler.
+func (s *lineScanner) scanContent() bool {
+	for s.Scan() {
+		if !isIgnored(s.Text()) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorAt, scanner'ın son okuduğu satır için bir ParseError oluşturur.
+func (s *lineScanner) errorAt(err error) *ParseError {
+	return &ParseError{Line: s.line, Text: s.Text(), Err: err}
+}
+
+// Parse, r'den bir lem-in haritası okur ve grafı ile karınca sayısını döndürür.
+// Sözdizimi hataları ilk hatada *ParseError olarak, kural ihlalleri ise hepsi birlikte
+// lemin.ValidationErrors olarak döner; ikisi de errors.Is ile Err* değerleriyle karşılaştırılabilir.
+func Parse(r io.Reader) (*lemin.Graph, int, error) {
+	graph := lemin.NewGraph()
+	scanner := &lineScanner{Scanner: bufio.NewScanner(r)}
+
+	// Sayıda karıncayı oku
+	if !scanner.scanContent() {
+		if err := scanner.Err(); err != nil {
+			return nil, 0, err
+		}
+		return nil, 0, scanner.errorAt(ErrInvalidAntCount)
+	}
+	antCount, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+	if err != nil || antCount <= 0 {
+		return nil, 0, scanner.errorAt(ErrInvalidAntCount)
+	}
+
+	// Bekleyen ##capacity komutu; komuttan sonra tanımlanan ilk odaya uygulanır.
+	capacity, capacityLine, capacityText := 0, 0, ""
+	setCapacity := func(id int) {
+		if capacity > 0 && graph.Nodes[id].Capacity == 0 {
+			graph.Nodes[id].Capacity = capacity // Dördüncü alanda verilen kapasite önceliklidir.
+		}
+		capacity = 0
+	}
+
+	// Graf verilerini oku
+	for scanner.scanContent() {
+		line := scanner.Text() // Bir sonraki satırı oku
+		if fields := strings.Fields(line); fields[0] == "##capacity" {
+			if len(fields) != 2 {
+				return nil, 0, scanner.errorAt(ErrInvalidCapacity)
+			}
+			n, err := strconv.Atoi(fields[1])
+			if err != nil || n <= 0 {
+				return nil, 0, scanner.errorAt(ErrInvalidCapacity)
+			}
+			capacity, capacityLine, capacityText = n, scanner.line, line
+			continue
+		}
+		if command := strings.TrimSpace(line); command == "##start" || command == "##end" {
+			// Komuttan sonraki ilk anlamlı satır (araya giren yorumlar ve boş satırlar atlanır),
+			// başlangıç veya bitiş odasını tanımlamalıdır.
+			isStart := command == "##start"
+			commandLine := scanner.line
+			if isStart {
+				graph.StartLines = append(graph.StartLines, commandLine)
+			} else {
+				graph.EndLines = append(graph.EndLines, commandLine)
+			}
+			if !scanner.scanContent() {
+				if err := scanner.Err(); err != nil {
+					return nil, 0, err
+				}
+				return nil, 0, &ParseError{Line: commandLine, Text: line, Err: ErrNoRoomAfterCommand}
+			}
+			fields := strings.Fields(scanner.Text())
+			if len(fields) < 3 || len(fields) > 4 {
+				return nil, 0, scanner.errorAt(ErrInvalidRoom)
+			}
+			id, err := addRoom(graph, fields, scanner.line)
+			if err != nil {
+				return nil, 0, scanner.errorAt(err)
+			}
+			setCapacity(id)
+			if isStart {
+				graph.StartNodeID = id // Graf yapısındaki başlangıç düğüm ID'sini güncelle
+			} else {
+				graph.EndNodeID = id // Graf yapısındaki bitiş düğüm ID'sini güncelle
+			}
+			continue
+		}
+
+		fields := strings.Fields(line)            // Satırı alanlara ayır
+		if len(fields) == 3 || len(fields) == 4 { // Eğer üç veya dört alana ayrılmışsa (isim, X, Y, kapasite)
+			id, err := addRoom(graph, fields, scanner.line)
+			if err != nil {
+				return nil, 0, scanner.errorAt(err)
+			}
+			setCapacity(id)
+		} else if len(fields) == 1 && strings.Contains(line, "-") { // Eğer bir alan içeriyor ve içinde "-" karakteri varsa (bir kenar)
+			if capacity > 0 {
+				return nil, 0, &ParseError{Line: capacityLine, Text: capacityText, Err: ErrNoRoomAfterCommand}
+			}
+			if err := addLink(graph, fields[0], scanner.line); err != nil {
+				return nil, 0, scanner.errorAt(err)
+			}
+		} else {
+			// Oda veya bağlantı olarak yorumlanamayan satır; Validate raporlar.
+			graph.Unparsed = append(graph.Unparsed, lemin.SourceLine{Line: scanner.line, Text: line})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+	if capacity > 0 {
+		return nil, 0, &ParseError{Line: capacityLine, Text: capacityText, Err: ErrNoRoomAfterCommand}
+	}
+
+	if err := checkGraph(graph); err != nil {
+		return nil, 0, err
+	}
+	return graph, antCount, nil
+}
+
+// isIgnored, satırın haritanın anlamını etkilemeyen bir satır olup olmadığını söyler: boş veya
+// yalnızca boşluktan oluşan satırlar, "#" ile başlayan yorumlar ve ##start/##end dışındaki
+// bilinmeyen "##" komutları. Bu satırlar atlanır ama harita yankısında olduğu gibi kalır.
+func isIgnored(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return true
+	}
+	return strings.HasPrefix(fields[0], "#") && !isCommand(fields)
+}
+
+// isCommand, satırın bilinen bir komut (##start, ##end veya ##capacity N) olup olmadığını söyler.
+func isCommand(fields []string) bool {
+	switch fields[0] {
+	case "##start", "##end":
+		return len(fields) == 1
+	case "##capacity":
+		return true
+	}
+	return false
+}
+
+// checkGraph, okunan grafın kurallara uyduğunu, başlangıç ve bitiş odalarının belirtildiğini ve
+// aralarında bir yol bulunduğunu denetler. ignored içindeki türden kural ihlalleri raporlanmaz.
+func checkGraph(graph *lemin.Graph, ignored ...error) error {
+	// Kural ihlallerinin hepsini birlikte raporla.
+	var violations lemin.ValidationErrors
+	for _, violation := range graph.Validate() {
+		if !isAny(violation, ignored) {
+			violations = append(violations, violation)
+		}
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+
+	if graph.StartNodeID == -1 {
+		return &ParseError{Err: ErrNoStart}
+	}
+	if graph.EndNodeID == -1 {
+		return &ParseError{Err: ErrNoEnd}
+	}
+	if graph.ShortestPath(graph.StartNodeID, graph.EndNodeID, nil) == nil {
+		return &ParseError{Err: ErrNoPath}
+	}
+	return nil
+}
+
+// isAny, err ignored içindeki hatalardan birine errors.Is ile eşitse true döndürür.
+func isAny(err error, ignored []error) bool {
+	for _, target := range ignored {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// addRoom, "isim x y [kapasite]" alanlarından bir oda oluşturup grafa ekler ve odanın ID'sini döndürür.
+func addRoom(graph *lemin.Graph, fields []string, line int) (int, error) {
+	name := fields[0]                 // Oda ismini al
+	x, err := strconv.Atoi(fields[1]) // X koordinatını al
+	if err != nil {
+		return -1, ErrInvalidRoom
+	}
+	y, err := strconv.Atoi(fields[2]) // Y koordinatını al
+	if err != nil {
+		return -1, ErrInvalidRoom
+	}
+	capacity := 0
+	if len(fields) == 4 {
+		capacity, err = strconv.Atoi(fields[3]) // Odanın kapasitesini al
+		if err != nil || capacity <= 0 {
+			return -1, ErrInvalidCapacity
+		}
+	}
+	id := graph.AddNode(name, x, y)
+	graph.Nodes[id].Line = line
+	graph.Nodes[id].Capacity = capacity
+	return id, nil
+}
+
+// addLink, "a-b" biçimindeki bir bağlantıyı grafa ekler. Bağlantının ardından isteğe bağlı olarak
+// kapasitesi ve geçiş süresi yazılabilir: "a-b:2:3" bir turda iki karıncanın girebildiği ve üç
+// turda geçilen bir bağlantıdır; "a-b:2" yalnızca kapasiteyi, "a-b::3" yalnızca süreyi belirtir.
+func addLink(graph *lemin.Graph, field string, line int) error {
+	field, properties, _ := strings.Cut(field, ":")
+	capacity, length, err := parseLinkProperties(properties)
+	if err != nil {
+		return err
+	}
+	edgeParts := strings.Split(field, "-") // Kenarı ayır
+	if len(edgeParts) != 2 {               // Eğer iki kısım yoksa (başlangıç ve bitiş düğümleri eksikse)
+		return ErrInvalidLink
+	}
+	startID := lemin.FindNodeIDByName(graph.Nodes, edgeParts[0]) // Başlangıç düğüm ID'sini bul
+	endID := lemin.FindNodeIDByName(graph.Nodes, edgeParts[1])   // Bitiş düğüm ID'sini bul
+	if startID == -1 || endID == -1 {                            // Eğer başlangıç veya bitiş düğümü bulunamadıysa
+		return ErrUnknownRoomInLink
+	}
+	graph.AddEdge(startID, endID)
+	edge := &graph.Edges[len(graph.Edges)-1]
+	edge.Line = line
+	edge.Capacity = capacity
+	edge.Length = length
+	return nil
+}
+
+// parseLinkProperties, "kapasite[:süre]" biçimindeki bağlantı özelliklerini çözer; boş bırakılan
+// özellikler için 0 döner.
+func parseLinkProperties(properties string) (int, int, error) {
+	if properties == "" {
+		return 0, 0, nil
+	}
+	parts := strings.Split(properties, ":")
+	if len(parts) > 2 {
+		return 0, 0, ErrInvalidLinkProperty
+	}
+	values := [2]int{}
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+		value, err := strconv.Atoi(part)
+		if err != nil || value <= 0 {
+			return 0, 0, ErrInvalidLinkProperty
+		}
+		values[i] = value
+	}
+	return values[0], values[1], nil
+}
@@ -0,0 +1,172 @@
+package parser_test
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"main.go/lemin"
+	"main.go/parser"
+)
+
+// baseMap, testlerde değiştirilerek kullanılan geçerli haritadır. Satırları:
+// 1 karınca sayısı, 2 ##start, 3 s, 4 a, 5 ##end, 6 e, 7 s-a, 8 a-e.
+const baseMap = "3\n##start\ns 0 0\na 1 0\n##end\ne 2 0\ns-a\na-e\n"
+
+// replaceLine, baseMap'in line. satırını text ile değiştirir; text boşsa satırı siler.
+func replaceLine(line int, text string) string {
+	lines := strings.Split(baseMap, "\n")
+	if text == "" {
+		return strings.Join(append(lines[:line-1:line-1], lines[line:]...), "\n")
+	}
+	lines[line-1] = text
+	return strings.Join(lines, "\n")
+}
+
+// insertLine, baseMap'in line. satırı olacak şekilde text satırını ekler.
+func insertLine(line int, text string) string {
+	lines := strings.Split(baseMap, "\n")
+	lines = append(lines[:line-1:line-1], append([]string{text}, lines[line-1:]...)...)
+	return strings.Join(lines, "\n")
+}
+
+// errorLines, Parse'ın döndürdüğü hatanın (veya kural ihlallerinin) satır numaralarını döndürür.
+func errorLines(t *testing.T, err error) []int {
+	t.Helper()
+	var violations lemin.ValidationErrors
+	if errors.As(err, &violations) {
+		lines := []int{}
+		for _, violation := range violations {
+			lines = append(lines, violation.(*lemin.ValidationError).Line)
+		}
+		return lines
+	}
+	var parseError *parser.ParseError
+	if errors.As(err, &parseError) {
+		return []int{parseError.Line}
+	}
+	t.Fatalf("beklenmeyen hata türü %T: %v", err, err)
+	return nil
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want error
+		line int // Hatanın satırı; dosyanın bütünüyle ilgili hatalarda 0
+	}{
+		{"boş dosya", "", parser.ErrInvalidAntCount, 1},
+		{"sıfır karınca", replaceLine(1, "0"), parser.ErrInvalidAntCount, 1},
+		{"sayı olmayan karınca sayısı", replaceLine(1, "üç"), parser.ErrInvalidAntCount, 1},
+		{"geçersiz koordinat", replaceLine(4, "a 1 x"), parser.ErrInvalidRoom, 4},
+		{"komuttan sonra geçersiz oda", replaceLine(3, "s 0"), parser.ErrInvalidRoom, 3},
+		{"geçersiz kapasite alanı", replaceLine(4, "a 1 0 0"), parser.ErrInvalidCapacity, 4},
+		{"geçersiz ##capacity", insertLine(4, "##capacity x"), parser.ErrInvalidCapacity, 4},
+		{"üç odalı bağlantı", replaceLine(8, "a-e-s"), parser.ErrInvalidLink, 8},
+		{"geçersiz bağlantı süresi", replaceLine(8, "a-e::0"), parser.ErrInvalidLinkProperty, 8},
+		{"tanımsız odaya bağlantı", replaceLine(8, "a-z"), parser.ErrUnknownRoomInLink, 8},
+		{"başlangıç yok", replaceLine(2, ""), parser.ErrNoStart, 0},
+		{"bitiş yok", replaceLine(5, ""), parser.ErrNoEnd, 0},
+		{"yol yok", replaceLine(8, ""), parser.ErrNoPath, 0},
+		{"##end sonrası oda yok", "3\n##start\ns 0 0\n##end\n", parser.ErrNoRoomAfterCommand, 4},
+		{"##capacity sonrası bağlantı", insertLine(7, "##capacity 2"), parser.ErrNoRoomAfterCommand, 7},
+		{"tekrar eden oda", insertLine(7, "a 3 0"), lemin.ErrDuplicateRoom, 7},
+		{"tekrar eden koordinat", insertLine(7, "b 1 0"), lemin.ErrDuplicateCoordinates, 7},
+		{"L ile başlayan oda", insertLine(7, "Lb 3 0"), lemin.ErrInvalidRoomName, 7},
+		{"kendine bağlantı", insertLine(9, "a-a"), lemin.ErrSelfLink, 9},
+		{"tekrar eden bağlantı", insertLine(9, "e-a"), lemin.ErrDuplicateLink, 9},
+		{"ikinci ##start", insertLine(7, "##start\nb 3 0"), lemin.ErrMultipleStart, 7},
+		{"ikinci ##end", insertLine(7, "##end\nb 3 0"), lemin.ErrMultipleEnd, 7},
+		{"bağlantıdan sonra oda", insertLine(9, "b 3 0"), lemin.ErrRoomAfterLink, 9},
+		{"yorumlanamayan satır", insertLine(9, "a e"), lemin.ErrUnparsedLine, 9},
+	}
+	for _, test := range tests {
+		_, _, err := parser.Parse(strings.NewReader(test.text))
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: hata %v, beklenen %v", test.name, err, test.want)
+			continue
+		}
+		if lines := errorLines(t, err); !reflect.DeepEqual(lines, []int{test.line}) {
+			t.Errorf("%s: hata satırları %v, beklenen %d", test.name, lines, test.line)
+		}
+	}
+}
+
+// Kural ihlallerinin hepsi, ilkinde durulmadan birlikte raporlanır.
+func TestParseReportsAllViolations(t *testing.T) {
+	file, err := os.Open("../badexample01.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	_, _, err = parser.Parse(file)
+	if !errors.Is(err, lemin.ErrSelfLink) || !errors.Is(err, lemin.ErrDuplicateLink) {
+		t.Fatalf("hata %v, beklenen 3-3 ve 8-7 ihlalleri", err)
+	}
+	if lines := errorLines(t, err); !reflect.DeepEqual(lines, []int{27, 31}) {
+		t.Errorf("hata satırları %v, beklenen [27 31]", lines)
+	}
+}
+
+// Yorumlar, bilinmeyen komutlar ve boş satırlar atlanır.
+func TestParseIgnoredLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		start string
+	}{
+		{"üç kelimelik yorum", insertLine(4, "# x y"), "s"},
+		{"bilinmeyen komut", insertLine(4, "##renk kırmızı"), "s"},
+		{"##start ile oda arasında yorum ve boş satır", insertLine(3, "# başlangıç odası\n"), "s"},
+	}
+	for _, test := range tests {
+		graph, ants, err := parser.Parse(strings.NewReader(test.text))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if ants != 3 || len(graph.Nodes) != 3 || graph.Nodes[graph.StartNodeID].Name != test.start {
+			t.Errorf("%s: %d karınca, %d oda, başlangıç %q", test.name, ants, len(graph.Nodes), graph.Nodes[graph.StartNodeID].Name)
+		}
+	}
+}
+
+func TestParseMovesErrors(t *testing.T) {
+	graph, _, err := parser.Parse(strings.NewReader(baseMap))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		text string
+		want error
+		line int
+	}{
+		{"karınca numarası yok", "L1-a\nLx-e\n", parser.ErrInvalidMove, 2},
+		{"L ile başlamayan hamle", "L1-a\nL1-e 2-a\n", parser.ErrInvalidMove, 2},
+		{"tanımsız oda", "L1-z\n", parser.ErrUnknownRoomInMove, 1},
+	}
+	for _, test := range tests {
+		_, err := parser.ParseMoves(strings.NewReader(test.text), graph)
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: hata %v, beklenen %v", test.name, err, test.want)
+			continue
+		}
+		if lines := errorLines(t, err); !reflect.DeepEqual(lines, []int{test.line}) {
+			t.Errorf("%s: hata satırları %v, beklenen %d", test.name, lines, test.line)
+		}
+	}
+}
+
+func TestParseDOTError(t *testing.T) {
+	_, _, err := parser.ParseDOT(strings.NewReader("graph {\n  s -- a;\n  a -- ;\n}\n"))
+	if !errors.Is(err, parser.ErrInvalidDOT) {
+		t.Fatalf("hata %v, beklenen %v", err, parser.ErrInvalidDOT)
+	}
+	if lines := errorLines(t, err); !reflect.DeepEqual(lines, []int{3}) {
+		t.Errorf("hata satırları %v, beklenen [3]", lines)
+	}
+}
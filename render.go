@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"main.go/i18n"
+	"main.go/lemin"
+	"main.go/parser"
+	"main.go/render"
+)
+
+// runRender, "render" alt komutunu çalıştırır: haritayı çözer ve odaları, bağlantıları ve seçilen
+// yolları SVG, PNG ve/veya DOT olarak çizer. Çıktı dosyası verilmezse SVG standart çıktıya yazılır.
+func runRender(args []string) {
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	svgFile := flags.String("svg", "", i18n.T("file to write the SVG image to"))
+	pngFile := flags.String("png", "", i18n.T("file to write the PNG image to"))
+	dotFile := flags.String("dot", "", i18n.T("file to write the GraphViz DOT graph to"))
+	solverName := flags.String("solver", lemin.DefaultSolver, i18n.T("path solver: ")+strings.Join(lemin.SolverNames(), ", "))
+	oneAntPerTunnel := flags.Bool("one-ant-per-tunnel", false, i18n.T("at most one ant may use each link per turn"))
+	langFlag(flags)
+	parseFlags(flags, args)
+
+	if flags.NArg() > 1 {
+		failUsage("usage: lem-in render [-svg=map.svg] [-png=map.png] [-dot=map.dot] [-solver=name] map.txt")
+	}
+
+	input, err := readInput(flags.Arg(0))
+	if err != nil {
+		fail(err)
+	}
+	graph, antCount, err := parser.ParseAny(input)
+	if err != nil {
+		fail(err)
+	}
+	solution, err := lemin.Solve(graph, antCount, lemin.Options{
+		Solver: *solverName,
+		Rules:  lemin.Rules{OneAntPerTunnel: *oneAntPerTunnel},
+	})
+	if err != nil {
+		fail(err)
+	}
+
+	if *svgFile == "" && *pngFile == "" && *dotFile == "" {
+		if err := render.SVG(os.Stdout, graph, solution); err != nil {
+			fail(err)
+		}
+		return
+	}
+	if *svgFile != "" {
+		writeOutput(*svgFile, func(w io.Writer) error { return render.SVG(w, graph, solution) })
+	}
+	if *pngFile != "" {
+		writeOutput(*pngFile, func(w io.Writer) error { return render.PNG(w, graph, solution) })
+	}
+	if *dotFile != "" {
+		writeOutput(*dotFile, func(w io.Writer) error { return render.DOT(w, graph, antCount, solution) })
+	}
+}
+
+// writeOutput, filename dosyasını oluşturur ve içeriğini write ile yazar.
+func writeOutput(filename string, write func(w io.Writer) error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		fail(err)
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		fail(err)
+	}
+	if err := file.Close(); err != nil {
+		fail(err)
+	}
+}
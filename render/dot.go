@@ -0,0 +1,70 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"main.go/lemin"
+)
+
+// DOT, grafı GraphViz DOT biçiminde w'ye yazar. Karınca sayısı "ants" graf özniteliği, başlangıç ve
+// bitiş odaları "start=true" ve "end=true" öznitelikleri, oda ve bağlantı kapasiteleri "capacity",
+// bağlantı süreleri "length", koordinatlar sabitlenmiş "pos" özniteliği olarak yazılır; böylece
+// çıktı parser.ParseDOT ile geri okunabilir ve neato ile aynı yerleşimde çizilir. solution nil
+// değilse seçilen yolların bağlantıları yolun rengiyle ve kalın çizilir.
+func DOT(w io.Writer, g *lemin.Graph, antCount int, solution *lemin.Solution) error {
+	// Her bağlantının ait olduğu yol
+	onPath := make(map[[2]int]int)
+	if solution != nil {
+		for i, path := range solution.Paths {
+			for j := 1; j < len(path); j++ {
+				onPath[[2]int{path[j-1], path[j]}] = i
+				onPath[[2]int{path[j], path[j-1]}] = i
+			}
+		}
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "graph lemin {")
+	fmt.Fprintf(out, "\tants=%d;\n", antCount)
+	fmt.Fprintln(out, "\tnode [shape=circle, style=filled, fillcolor=\"#ffffff\"];")
+	for _, node := range g.Nodes {
+		attrs := fmt.Sprintf("pos=\"%d,%d!\"", node.X, node.Y)
+		if node.Capacity > 1 {
+			attrs += fmt.Sprintf(", capacity=%d", node.Capacity)
+		}
+		switch node.ID {
+		case g.StartNodeID:
+			attrs += fmt.Sprintf(", start=true, fillcolor=%q", hex(startColor))
+		case g.EndNodeID:
+			attrs += fmt.Sprintf(", end=true, fillcolor=%q", hex(endColor))
+		}
+		fmt.Fprintf(out, "\t%s [%s];\n", strconv.Quote(node.Name), attrs)
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(out, "\t%s -- %s", strconv.Quote(g.Nodes[edge.Start].Name), strconv.Quote(g.Nodes[edge.End].Name))
+		attrs := []string{}
+		if edge.Capacity > 0 {
+			attrs = append(attrs, fmt.Sprintf("capacity=%d", edge.Capacity))
+		}
+		if edge.Length > 1 {
+			attrs = append(attrs, fmt.Sprintf("length=%d", edge.Length))
+		}
+		if i, ok := onPath[[2]int{edge.Start, edge.End}]; ok {
+			attrs = append(attrs, fmt.Sprintf("color=%q", hex(pathColor(i))), "penwidth=3")
+			// Yolun karınca sayısı, yolun başlangıç odasından çıkan bağlantısına yazılır.
+			if edge.Start == g.StartNodeID || edge.End == g.StartNodeID {
+				attrs = append(attrs, fmt.Sprintf("label=\"%d\"", solution.AntCounts[i]))
+			}
+		}
+		if len(attrs) > 0 {
+			fmt.Fprintf(out, " [%s]", strings.Join(attrs, ", "))
+		}
+		fmt.Fprintln(out, ";")
+	}
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
@@ -0,0 +1,237 @@
+package render
+
+import (
+	"html/template"
+	"io"
+
+	"main.go/i18n"
+	"main.go/lemin"
+)
+
+// htmlRoom ve htmlData, sayfadaki betiğe JSON olarak gömülen verilerdir.
+type htmlRoom struct {
+	Name string `json:"name"`
+	X    int    `json:"x"`
+	Y    int    `json:"y"`
+}
+
+// htmlLabels, sayfadaki düğme ve etiketlerin seçilen dildeki metinleridir.
+type htmlLabels struct {
+	Play  string `json:"play"`
+	Pause string `json:"pause"`
+	Speed string `json:"speed"`
+	Turn  string `json:"turn"`
+}
+
+type htmlData struct {
+	Lang   string     `json:"lang"`
+	Labels htmlLabels `json:"labels"`
+	Width  int        `json:"width"`
+	Height int        `json:"height"`
+	Ants   int        `json:"ants"`
+	Start  int        `json:"start"`
+	End    int        `json:"end"`
+	Rooms  []htmlRoom `json:"rooms"`
+	Links  [][2]int   `json:"links"`
+	Turns  [][][2]int `json:"turns"` // Her tur için [karınca, oda ID] çiftleri
+}
+
+// HTML, haritayı ve turns hamlelerini tarayıcıda oynatan, dışa bağımlılığı olmayan tek bir HTML
+// sayfası olarak w'ye yazar. Sayfada oynat/duraklat düğmesi, tur kaydırıcısı ve hız seçimi bulunur.
+func HTML(w io.Writer, g *lemin.Graph, antCount int, turns [][]lemin.Move) error {
+	geo := newGeometry(g)
+	data := htmlData{
+		Lang: i18n.Language(),
+		Labels: htmlLabels{
+			Play:  i18n.T("Play"),
+			Pause: i18n.T("Pause"),
+			Speed: i18n.T("Speed"),
+			Turn:  i18n.T("Turn"),
+		},
+		Width:  geo.width,
+		Height: geo.height,
+		Ants:   antCount,
+		Start:  g.StartNodeID,
+		End:    g.EndNodeID,
+		Rooms:  make([]htmlRoom, len(g.Nodes)),
+		Links:  make([][2]int, len(g.Edges)),
+		Turns:  make([][][2]int, len(turns)),
+	}
+	for i, node := range g.Nodes {
+		x, y := geo.point(node)
+		data.Rooms[i] = htmlRoom{Name: node.Name, X: x, Y: y}
+	}
+	for i, edge := range g.Edges {
+		data.Links[i] = [2]int{edge.Start, edge.End}
+	}
+	for t, turn := range turns {
+		data.Turns[t] = make([][2]int, len(turn))
+		for i, move := range turn {
+			data.Turns[t][i] = [2]int{move.Ant, move.Room}
+		}
+	}
+	return htmlTemplate.Execute(w, data)
+}
+
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<title>lem-in</title>
+<style>
+body { font-family: sans-serif; margin: 16px; }
+#controls { margin-bottom: 8px; }
+#controls * { vertical-align: middle; margin-right: 8px; }
+#turn { width: 320px; }
+svg line { stroke: #bbbbbb; stroke-width: 2; }
+svg .room { fill: #ffffff; stroke: #333333; stroke-width: 1.5; }
+svg .start { fill: #2ea043; }
+svg .end { fill: #d73a49; }
+svg .ant { fill: #1f77b4; }
+svg text { font-size: 12px; fill: #333333; }
+svg .antLabel { font-size: 9px; fill: #ffffff; text-anchor: middle; dominant-baseline: central; }
+</style>
+</head>
+<body>
+<div id="controls">
+<button id="play">{{.Labels.Play}}</button>
+<input id="turn" type="range" min="0" step="0.01" value="0">
+<span id="label"></span>
+<label>{{.Labels.Speed}} <select id="speed">
+<option value="0.5">0.5x</option>
+<option value="1" selected>1x</option>
+<option value="2">2x</option>
+<option value="4">4x</option>
+</select></label>
+</div>
+<svg id="map" xmlns="http://www.w3.org/2000/svg"></svg>
+<script>
+var data = {{.}};
+var svgNS = "http://www.w3.org/2000/svg";
+var map = document.getElementById("map");
+var slider = document.getElementById("turn");
+var label = document.getElementById("label");
+var playButton = document.getElementById("play");
+var speed = document.getElementById("speed");
+
+map.setAttribute("width", data.width);
+map.setAttribute("height", data.height);
+slider.max = data.turns.length;
+
+function element(name, attrs, parent) {
+	var e = document.createElementNS(svgNS, name);
+	for (var key in attrs) {
+		e.setAttribute(key, attrs[key]);
+	}
+	(parent || map).appendChild(e);
+	return e;
+}
+
+// Bağlantılar, odalar ve isimler bir kez çizilir.
+data.links.forEach(function (link) {
+	var a = data.rooms[link[0]], b = data.rooms[link[1]];
+	element("line", {x1: a.x, y1: a.y, x2: b.x, y2: b.y});
+});
+var counters = {};
+data.rooms.forEach(function (room, id) {
+	var cls = "room" + (id === data.start ? " start" : id === data.end ? " end" : "");
+	element("circle", {cx: room.x, cy: room.y, r: 8, "class": cls});
+	var text = element("text", {x: room.x + 10, y: room.y - 8});
+	text.textContent = room.name;
+	if (id === data.start || id === data.end) {
+		counters[id] = text;
+	}
+});
+
+// frames[t][ant], t. turun sonunda karıncanın bulunduğu odadır.
+var frames = [];
+var position = [];
+for (var ant = 1; ant <= data.ants; ant++) {
+	position[ant] = data.start;
+}
+frames.push(position.slice());
+data.turns.forEach(function (turn) {
+	turn.forEach(function (move) {
+		position[move[0]] = move[1];
+	});
+	frames.push(position.slice());
+});
+
+var ants = [];
+for (var ant = 1; ant <= data.ants; ant++) {
+	var group = element("g", {});
+	element("circle", {r: 7, "class": "ant"}, group);
+	element("text", {"class": "antLabel"}, group).textContent = ant;
+	ants[ant] = group;
+}
+
+// draw, t anındaki durumu çizer; t kesirliyse karıncalar iki tur arasındaki yolda gösterilir.
+function draw(t) {
+	var turn = Math.floor(t), progress = t - turn;
+	if (turn >= data.turns.length) {
+		turn = data.turns.length;
+		progress = 0;
+	}
+	var from = frames[turn], to = frames[Math.min(turn + 1, frames.length - 1)];
+	var waiting = 0, arrived = 0;
+	for (var ant = 1; ant <= data.ants; ant++) {
+		var a = data.rooms[from[ant]], b = data.rooms[to[ant]];
+		var moving = from[ant] !== to[ant] && progress > 0;
+		var room = moving ? -1 : from[ant];
+		if (room === data.start) {
+			waiting++;
+		} else if (room === data.end) {
+			arrived++;
+		}
+		var hidden = room === data.start || room === data.end;
+		ants[ant].style.display = hidden ? "none" : "";
+		var x = a.x + (b.x - a.x) * (moving ? progress : 0);
+		var y = a.y + (b.y - a.y) * (moving ? progress : 0);
+		ants[ant].setAttribute("transform", "translate(" + x + "," + y + ")");
+	}
+	counters[data.start].textContent = data.rooms[data.start].name + " (" + waiting + ")";
+	counters[data.end].textContent = data.rooms[data.end].name + " (" + arrived + ")";
+	label.textContent = data.labels.turn + " " + turn + "/" + data.turns.length;
+}
+
+var playing = false, last = null;
+function tick(now) {
+	if (!playing) {
+		return;
+	}
+	if (last !== null) {
+		var t = parseFloat(slider.value) + (now - last) / 1000 * parseFloat(speed.value);
+		if (t >= data.turns.length) {
+			t = data.turns.length;
+			setPlaying(false);
+		}
+		slider.value = t;
+		draw(t);
+	}
+	last = now;
+	requestAnimationFrame(tick);
+}
+
+function setPlaying(value) {
+	playing = value;
+	last = null;
+	playButton.textContent = playing ? data.labels.pause : data.labels.play;
+	if (playing) {
+		requestAnimationFrame(tick);
+	}
+}
+
+playButton.addEventListener("click", function () {
+	if (!playing && parseFloat(slider.value) >= data.turns.length) {
+		slider.value = 0;
+	}
+	setPlaying(!playing);
+});
+slider.addEventListener("input", function () {
+	draw(parseFloat(slider.value));
+});
+draw(0);
+</script>
+</body>
+</html>
+`))
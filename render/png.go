@@ -0,0 +1,146 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strconv"
+
+	"main.go/lemin"
+)
+
+// digitFont, rakamların 3x5 piksellik bit eşlemleridir; her satırın en düşük üç biti soldan sağa
+// pikselleri belirtir. Standart kütüphanede yazı tipi olmadığı için PNG'ye yalnızca sayılar yazılır.
+var digitFont = [10][5]uint8{
+	{7, 5, 5, 5, 7}, // 0
+	{2, 6, 2, 2, 7}, // 1
+	{7, 1, 7, 4, 7}, // 2
+	{7, 1, 7, 1, 7}, // 3
+	{5, 5, 7, 1, 1}, // 4
+	{7, 4, 7, 1, 7}, // 5
+	{7, 4, 7, 5, 7}, // 6
+	{7, 1, 1, 1, 1}, // 7
+	{7, 5, 7, 5, 7}, // 8
+	{7, 5, 7, 1, 7}, // 9
+}
+
+// digitScale, rakamların büyütme oranıdır.
+const digitScale = 2
+
+// PNG, SVG ile aynı çizimi PNG olarak w'ye yazar. Oda isimleri yazılmaz; açıklamada her yolun
+// renginin yanına yola atanan karınca sayısı rakamlarla çizilir.
+func PNG(w io.Writer, g *lemin.Graph, solution *lemin.Solution) error {
+	geo := newGeometry(g)
+	img := image.NewRGBA(image.Rect(0, 0, geo.width, geo.height+legendHeight(solution)))
+	draw.Draw(img, img.Bounds(), &image.Uniform{background}, image.Point{}, draw.Src)
+
+	for _, edge := range g.Edges {
+		x1, y1 := geo.point(g.Nodes[edge.Start])
+		x2, y2 := geo.point(g.Nodes[edge.End])
+		drawLine(img, x1, y1, x2, y2, 1, linkColor)
+	}
+
+	if solution != nil {
+		for i, path := range solution.Paths {
+			for j := 1; j < len(path); j++ {
+				x1, y1 := geo.point(g.Nodes[path[j-1]])
+				x2, y2 := geo.point(g.Nodes[path[j]])
+				drawLine(img, x1, y1, x2, y2, 3, pathColor(i))
+			}
+		}
+	}
+
+	for _, node := range g.Nodes {
+		x, y := geo.point(node)
+		fill := roomColor
+		switch node.ID {
+		case g.StartNodeID:
+			fill = startColor
+		case g.EndNodeID:
+			fill = endColor
+		}
+		fillCircle(img, x, y, roomRadius, edgeColor)
+		fillCircle(img, x, y, roomRadius-2, fill)
+	}
+
+	if solution != nil {
+		for i := range solution.Paths {
+			y := geo.height + i*legendLine
+			draw.Draw(img, image.Rect(margin, y, margin+12, y+12), &image.Uniform{pathColor(i)}, image.Point{}, draw.Src)
+			drawNumber(img, margin+18, y+1, solution.AntCounts[i], edgeColor)
+		}
+	}
+
+	return png.Encode(w, img)
+}
+
+// drawLine, iki nokta arasına Bresenham algoritmasıyla, her noktada (2*radius+1) piksellik kare
+// boyayarak kalın bir çizgi çeker.
+func drawLine(img *image.RGBA, x0 int, y0 int, x1 int, y1 int, radius int, c color.RGBA) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	stepX, stepY := sign(x1-x0), sign(y1-y0)
+	err := dx + dy
+	for {
+		draw.Draw(img, image.Rect(x0-radius, y0-radius, x0+radius+1, y0+radius+1), &image.Uniform{c}, image.Point{}, draw.Src)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += stepX
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += stepY
+		}
+	}
+}
+
+// fillCircle, (cx, cy) merkezli r yarıçaplı dolu bir daire çizer.
+func fillCircle(img *image.RGBA, cx int, cy int, r int, c color.RGBA) {
+	for y := -r; y <= r; y++ {
+		for x := -r; x <= r; x++ {
+			if x*x+y*y <= r*r {
+				img.SetRGBA(cx+x, cy+y, c)
+			}
+		}
+	}
+}
+
+// drawNumber, n sayısını sol üst köşesi (x, y) olacak şekilde digitFont ile yazar.
+func drawNumber(img *image.RGBA, x int, y int, n int, c color.RGBA) {
+	for _, r := range strconv.Itoa(n) {
+		if r < '0' || r > '9' {
+			continue
+		}
+		for row, bits := range digitFont[r-'0'] {
+			for col := 0; col < 3; col++ {
+				if bits&(4>>col) != 0 {
+					px, py := x+col*digitScale, y+row*digitScale
+					draw.Draw(img, image.Rect(px, py, px+digitScale, py+digitScale), &image.Uniform{c}, image.Point{}, draw.Src)
+				}
+			}
+		}
+		x += 4 * digitScale
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,102 @@
+// Package render, haritayı ve seçilen yolları SVG, PNG ve GraphViz DOT olarak çizer; karıncaların
+// hareketini tarayıcıda oynatan HTML sayfası da üretir.
+package render
+
+import (
+	"image/color"
+	"math"
+
+	"main.go/lemin"
+)
+
+const (
+	margin     = 40  // Resmin kenarlarında bırakılan boşluk (piksel)
+	maxSize    = 800 // Haritanın çizildiği alanın en büyük boyutu (piksel)
+	roomRadius = 8   // Oda dairesinin yarıçapı (piksel)
+	legendLine = 20  // Açıklama satırlarının yüksekliği (piksel)
+)
+
+// Renkler: bağlantılar gri, başlangıç yeşil, bitiş kırmızı; her yol palette'ten farklı bir renk alır.
+var (
+	background = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	linkColor  = color.RGBA{0xbb, 0xbb, 0xbb, 0xff}
+	roomColor  = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	edgeColor  = color.RGBA{0x33, 0x33, 0x33, 0xff}
+	startColor = color.RGBA{0x2e, 0xa0, 0x43, 0xff}
+	endColor   = color.RGBA{0xd7, 0x3a, 0x49, 0xff}
+	palette    = []color.RGBA{
+		{0x1f, 0x77, 0xb4, 0xff},
+		{0xff, 0x7f, 0x0e, 0xff},
+		{0x94, 0x67, 0xbd, 0xff},
+		{0x8c, 0x56, 0x4b, 0xff},
+		{0xe3, 0x77, 0xc2, 0xff},
+		{0x17, 0xbe, 0xcf, 0xff},
+		{0xbc, 0xbd, 0x22, 0xff},
+		{0x7f, 0x7f, 0x7f, 0xff},
+	}
+)
+
+// pathColor, i. yolun rengini verir; yol sayısı paletten fazlaysa renkler tekrar eder.
+func pathColor(i int) color.RGBA {
+	return palette[i%len(palette)]
+}
+
+// geometry, harita koordinatlarını resim piksellerine çevirir.
+type geometry struct {
+	minX, minY int
+	scale      float64 // Bir koordinat biriminin piksel karşılığı (büyük haritalarda birden küçük)
+	width      int     // Resmin genişliği
+	height     int     // Açıklama hariç resmin yüksekliği
+}
+
+// newGeometry, haritayı en fazla maxSize piksellik bir alana sığdıracak ölçeği hesaplar; geniş
+// haritalar küçültülür, küçük haritalar en fazla 60 kat büyütülür.
+func newGeometry(g *lemin.Graph) geometry {
+	geo := geometry{scale: 1}
+	if len(g.Nodes) == 0 {
+		geo.width, geo.height = 2*margin, 2*margin
+		return geo
+	}
+	maxX, maxY := g.Nodes[0].X, g.Nodes[0].Y
+	geo.minX, geo.minY = maxX, maxY
+	for _, node := range g.Nodes {
+		if node.X < geo.minX {
+			geo.minX = node.X
+		}
+		if node.X > maxX {
+			maxX = node.X
+		}
+		if node.Y < geo.minY {
+			geo.minY = node.Y
+		}
+		if node.Y > maxY {
+			maxY = node.Y
+		}
+	}
+	span := maxX - geo.minX
+	if maxY-geo.minY > span {
+		span = maxY - geo.minY
+	}
+	if span > 0 {
+		geo.scale = float64(maxSize) / float64(span)
+		if span <= maxSize {
+			geo.scale = float64(maxSize / span) // Sığan haritalar tam sayı katıyla büyütülür.
+		}
+	}
+	if geo.scale > 60 {
+		geo.scale = 60 // Küçük haritalar aşırı büyütülmez.
+	}
+	geo.width = geo.pixels(maxX-geo.minX) + 2*margin
+	geo.height = geo.pixels(maxY-geo.minY) + 2*margin
+	return geo
+}
+
+// pixels, harita birimindeki bir uzunluğun piksel karşılığını döndürür.
+func (geo geometry) pixels(units int) int {
+	return int(math.Round(float64(units) * geo.scale))
+}
+
+// point, odanın resimdeki piksel konumunu döndürür.
+func (geo geometry) point(node lemin.Node) (int, int) {
+	return geo.pixels(node.X-geo.minX) + margin, geo.pixels(node.Y-geo.minY) + margin
+}
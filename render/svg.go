@@ -0,0 +1,90 @@
+package render
+
+import (
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+	"strings"
+
+	"main.go/i18n"
+	"main.go/lemin"
+)
+
+// SVG, haritayı SVG olarak w'ye yazar: bağlantılar gri çizgiler, odalar isimli daireler olarak
+// çizilir; başlangıç ve bitiş renklendirilir. solution nil değilse seçilen her yol ayrı bir renkle
+// çizilir ve resmin altındaki açıklamada yola atanan karınca sayısı yazılır.
+func SVG(w io.Writer, g *lemin.Graph, solution *lemin.Solution) error {
+	geo := newGeometry(g)
+	height := geo.height + legendHeight(solution)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\" font-family=\"sans-serif\" font-size=\"12\">\n",
+		geo.width, height, geo.width, height)
+	fmt.Fprintf(&b, "<rect width=\"100%%\" height=\"100%%\" fill=\"%s\"/>\n", hex(background))
+
+	// Bağlantılar
+	fmt.Fprintf(&b, "<g stroke=\"%s\" stroke-width=\"2\">\n", hex(linkColor))
+	for _, edge := range g.Edges {
+		x1, y1 := geo.point(g.Nodes[edge.Start])
+		x2, y2 := geo.point(g.Nodes[edge.End])
+		fmt.Fprintf(&b, "<line x1=\"%d\" y1=\"%d\" x2=\"%d\" y2=\"%d\"/>\n", x1, y1, x2, y2)
+	}
+	b.WriteString("</g>\n")
+
+	// Seçilen yollar, bağlantıların üzerine yarı saydam kalın çizgilerle çizilir.
+	if solution != nil {
+		for i, path := range solution.Paths {
+			points := make([]string, len(path))
+			for j, nodeID := range path {
+				x, y := geo.point(g.Nodes[nodeID])
+				points[j] = fmt.Sprintf("%d,%d", x, y)
+			}
+			fmt.Fprintf(&b, "<polyline points=\"%s\" fill=\"none\" stroke=\"%s\" stroke-width=\"6\" stroke-opacity=\"0.7\" stroke-linejoin=\"round\"/>\n",
+				strings.Join(points, " "), hex(pathColor(i)))
+		}
+	}
+
+	// Odalar ve isimleri
+	for _, node := range g.Nodes {
+		x, y := geo.point(node)
+		fill := roomColor
+		switch node.ID {
+		case g.StartNodeID:
+			fill = startColor
+		case g.EndNodeID:
+			fill = endColor
+		}
+		fmt.Fprintf(&b, "<circle cx=\"%d\" cy=\"%d\" r=\"%d\" fill=\"%s\" stroke=\"%s\" stroke-width=\"1.5\"/>\n",
+			x, y, roomRadius, hex(fill), hex(edgeColor))
+		fmt.Fprintf(&b, "<text x=\"%d\" y=\"%d\" fill=\"%s\">%s</text>\n",
+			x+roomRadius+2, y-roomRadius, hex(edgeColor), html.EscapeString(node.Name))
+	}
+
+	// Açıklama: her yolun rengi, karınca sayısı ve uzunluğu
+	if solution != nil {
+		for i, path := range solution.Paths {
+			y := geo.height + i*legendLine
+			fmt.Fprintf(&b, "<rect x=\"%d\" y=\"%d\" width=\"12\" height=\"12\" fill=\"%s\"/>\n", margin, y, hex(pathColor(i)))
+			fmt.Fprintf(&b, "<text x=\"%d\" y=\"%d\" fill=\"%s\">%s</text>\n", margin+18, y+11, hex(edgeColor),
+				html.EscapeString(i18n.T("Path %d: %d ants, %d links", i+1, solution.AntCounts[i], len(path)-1)))
+		}
+	}
+	b.WriteString("</svg>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// legendHeight, açıklama için resmin altına eklenen yüksekliktir.
+func legendHeight(solution *lemin.Solution) int {
+	if solution == nil {
+		return 0
+	}
+	return len(solution.Paths) * legendLine
+}
+
+// hex, rengi "#rrggbb" biçiminde döndürür.
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
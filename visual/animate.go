@@ -0,0 +1,124 @@
+package visual
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"main.go/i18n"
+	"main.go/lemin"
+)
+
+// labelMargin, çizim alanının sağında oda etiketleri için bırakılan sütun sayısıdır.
+const labelMargin = 12
+
+// clearScreen, imleci başa alıp terminali temizleyen ANSI kaçış dizisidir.
+const clearScreen = "\033[H\033[2J"
+
+// Options, animasyonun boyutunu ve hızını ayarlar.
+type Options struct {
+	Width  int           // Çizim alanının genişliği (0 ise 78)
+	Height int           // Çizim alanının yüksekliği (0 ise 20)
+	Delay  time.Duration // Turlar arasındaki bekleme süresi
+	Step   bool          // true ise her turdan sonra Keys'ten Enter beklenir
+	Keys   io.Reader     // Adım adım modda tuşların okunduğu kaynak
+}
+
+// Animate, haritayı çizer ve turns hamlelerini tur tur oynatarak her turu w'ye yeni bir kare olarak yazar.
+func Animate(w io.Writer, g *lemin.Graph, antCount int, turns [][]lemin.Move, opts Options) error {
+	if opts.Width <= 0 {
+		opts.Width = 78
+	}
+	if opts.Height <= 0 {
+		opts.Height = 20
+	}
+	var keys *bufio.Reader
+	if opts.Step && opts.Keys != nil {
+		keys = bufio.NewReader(opts.Keys)
+	}
+
+	// Bütün karıncalar başlangıç odasından yola çıkar.
+	positions := make([]int, antCount+1)
+	for ant := 1; ant <= antCount; ant++ {
+		positions[ant] = g.StartNodeID
+	}
+
+	for t := 0; t <= len(turns); t++ {
+		moves := []string{}
+		if t > 0 {
+			for _, move := range turns[t-1] {
+				positions[move.Ant] = move.Room
+				moves = append(moves, g.FormatMove(move))
+			}
+		}
+
+		frame := Render(g, positions, opts.Width, opts.Height)
+		if _, err := fmt.Fprintf(w, "%s%s%s\n%s\n", clearScreen, frame, i18n.T("Turn %d/%d", t, len(turns)), strings.Join(moves, " ")); err != nil {
+			return err
+		}
+
+		if t == len(turns) {
+			break
+		}
+		if keys != nil {
+			fmt.Fprint(w, i18n.T("Press Enter to continue..."))
+			if _, err := keys.ReadString('\n'); err != nil {
+				return err
+			}
+		} else {
+			time.Sleep(opts.Delay)
+		}
+	}
+	return nil
+}
+
+// Render, haritanın bir karesini çizer: bağlantılar çizgilerle, odalar işaretleriyle gösterilir.
+// positions[ant], karıncanın bulunduğu odanın ID'sidir (indeks 0 kullanılmaz). Başlangıç odası 'S',
+// bitiş odası 'E', boş odalar 'o', karınca bulunan odalar '@' ile işaretlenir; boş odaların yanına
+// isimleri, dolu odaların yanına karınca numarası, başlangıç ve bitişin yanına karınca sayısı yazılır.
+func Render(g *lemin.Graph, positions []int, width int, height int) string {
+	c := newCanvas(width, height)
+	// Sağ kenardaki odaların etiketleri için yer bırakılır.
+	cells := layout(g, width-labelMargin, height)
+
+	// Her odadaki karıncalar
+	ants := make(map[int][]int)
+	for ant := 1; ant < len(positions); ant++ {
+		ants[positions[ant]] = append(ants[positions[ant]], ant)
+	}
+
+	for _, edge := range g.Edges {
+		from, to := cells[edge.Start], cells[edge.End]
+		c.line(from[0], from[1], to[0], to[1])
+	}
+
+	for _, node := range g.Nodes {
+		col, row := cells[node.ID][0], cells[node.ID][1]
+		marker, label := 'o', node.Name
+		switch {
+		case node.ID == g.StartNodeID:
+			marker, label = 'S', fmt.Sprintf("%s(%d)", node.Name, len(ants[node.ID]))
+		case node.ID == g.EndNodeID:
+			marker, label = 'E', fmt.Sprintf("%s(%d)", node.Name, len(ants[node.ID]))
+		case len(ants[node.ID]) > 0:
+			marker, label = '@', fmt.Sprintf("L%d", ants[node.ID][0])
+		}
+		c.set(col, row, marker)
+
+		// Etiket yalnızca yanındaki hücreler boşsa yazılır; böylece başka odaların üzerine taşmaz.
+		fits := true
+		for i := range []rune(label) {
+			cell := col + 1 + i
+			if cell >= width || (!c.free(cell, row) && c.cells[row][cell] != '-') {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			c.text(col+1, row, label)
+		}
+	}
+	return c.String()
+}
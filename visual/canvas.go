@@ -0,0 +1,141 @@
+// Package visual, haritayı ve karıncaların hareketini terminalde ASCII karakterlerle çizer.
+package visual
+
+import (
+	"strings"
+
+	"main.go/lemin"
+)
+
+// canvas, karakterlerden oluşan bir çizim alanıdır.
+type canvas struct {
+	cells [][]rune
+}
+
+func newCanvas(width int, height int) *canvas {
+	c := &canvas{cells: make([][]rune, height)}
+	for row := range c.cells {
+		c.cells[row] = []rune(strings.Repeat(" ", width))
+	}
+	return c
+}
+
+// set, alanın içindeyse (col, row) hücresine r karakterini yazar.
+func (c *canvas) set(col int, row int, r rune) {
+	if row >= 0 && row < len(c.cells) && col >= 0 && col < len(c.cells[row]) {
+		c.cells[row][col] = r
+	}
+}
+
+// free, (col, row) hücresi alanın içinde ve boşsa true döndürür.
+func (c *canvas) free(col int, row int) bool {
+	return row >= 0 && row < len(c.cells) && col >= 0 && col < len(c.cells[row]) && c.cells[row][col] == ' '
+}
+
+// text, (col, row) hücresinden başlayarak s metnini yazar.
+func (c *canvas) text(col int, row int, s string) {
+	for i, r := range []rune(s) {
+		c.set(col+i, row, r)
+	}
+}
+
+// line, iki hücre arasına Bresenham algoritmasıyla, yönüne uygun karakterlerden bir çizgi çeker.
+// Uç hücreler odalar için boş bırakılır.
+func (c *canvas) line(col0 int, row0 int, col1 int, row1 int) {
+	dCol, dRow := abs(col1-col0), -abs(row1-row0)
+	stepCol, stepRow := sign(col1-col0), sign(row1-row0)
+
+	// Çizginin eğimine göre kullanılacak karakter
+	r := '-'
+	switch {
+	case dCol == 0:
+		r = '|'
+	case -dRow > dCol*2:
+		r = '|'
+	case -dRow*2 > dCol:
+		if stepCol == stepRow {
+			r = '\\'
+		} else {
+			r = '/'
+		}
+	}
+
+	col, row := col0, row0
+	err := dCol + dRow
+	for col != col1 || row != row1 {
+		if (col != col0 || row != row0) && c.free(col, row) {
+			c.set(col, row, r)
+		}
+		e2 := 2 * err
+		if e2 >= dRow {
+			err += dRow
+			col += stepCol
+		}
+		if e2 <= dCol {
+			err += dCol
+			row += stepRow
+		}
+	}
+}
+
+func (c *canvas) String() string {
+	var b strings.Builder
+	for _, row := range c.cells {
+		b.WriteString(strings.TrimRight(string(row), " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// layout, oda koordinatlarını width x height boyutundaki alana ölçekler ve her odanın
+// (sütun, satır) konumunu döndürür.
+func layout(g *lemin.Graph, width int, height int) [][2]int {
+	positions := make([][2]int, len(g.Nodes))
+	if len(g.Nodes) == 0 {
+		return positions
+	}
+	minX, maxX, minY, maxY := g.Nodes[0].X, g.Nodes[0].X, g.Nodes[0].Y, g.Nodes[0].Y
+	for _, node := range g.Nodes {
+		if node.X < minX {
+			minX = node.X
+		}
+		if node.X > maxX {
+			maxX = node.X
+		}
+		if node.Y < minY {
+			minY = node.Y
+		}
+		if node.Y > maxY {
+			maxY = node.Y
+		}
+	}
+	for i, node := range g.Nodes {
+		positions[i] = [2]int{scale(node.X, minX, maxX, width), scale(node.Y, minY, maxY, height)}
+	}
+	return positions
+}
+
+// scale, [min, max] aralığındaki v değerini [0, size) aralığına çevirir.
+func scale(v int, min int, max int, size int) int {
+	if max == min || size <= 1 {
+		return 0
+	}
+	return (v - min) * (size - 1) / (max - min)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}